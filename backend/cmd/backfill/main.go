@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aussiebroadwan/tipping/backend/config"
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+	"github.com/aussiebroadwan/tipping/backend/internal/models"
+	"github.com/aussiebroadwan/tipping/backend/internal/services"
+	"github.com/aussiebroadwan/tipping/backend/internal/utils"
+)
+
+var (
+	lg *slog.Logger
+
+	nrlApiBase string
+
+	competitionsFlag = flag.String("competitions", "111,161,116,156", "Comma separated competition IDs to import")
+	seasonsFlag      = flag.String("seasons", strconv.Itoa(time.Now().Year()), "Season or range of seasons to import (e.g. 2020-2024)")
+	roundsFlag       = flag.String("rounds", "1-35", "Round or range of rounds to import (e.g. 1-27)")
+	forceFlag        = flag.Bool("force", false, "Re-import rounds that have already been completed")
+	delayFlag        = flag.Duration("delay", 2*time.Second, "Delay between requests to the NRL API")
+)
+
+func init() {
+	// Create logger
+	lg = slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	// Check for required environment variables
+	missing := utils.MissingDBEnvVars()
+	for _, envVar := range missing {
+		lg.Error("Environment variable " + envVar + " is required")
+	}
+
+	if len(missing) > 0 {
+		os.Exit(1)
+	}
+
+	if nrlApiBase = os.Getenv("NRL_API_BASE_URL"); nrlApiBase == "" {
+		lg.Error("NRL_API_BASE_URL environment variable is required")
+		os.Exit(1)
+	}
+}
+
+// backfill imports historical fixtures and results from the NRL API for a range
+// of seasons and rounds. Rounds that have been fully imported are recorded, so
+// an interrupted backfill can be run again and will resume where it left off.
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		lg.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+// run performs the backfill, returning an error if it could not be started or
+// was interrupted, so the database connection is always closed before exiting.
+func run() error {
+	competitionIDs, err := parseCompetitions(*competitionsFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -competitions: %w", err)
+	}
+
+	firstSeason, lastSeason, err := parseRange(*seasonsFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -seasons: %w", err)
+	}
+
+	firstRound, lastRound, err := parseRange(*roundsFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -rounds: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	conn, err := utils.ConnectDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	queries := db.New(conn)
+
+	// The response cache is deliberately not used here, a round that was fetched
+	// but not stored before an interruption must be imported again on resume.
+	nrlService := services.NewNRLService(nrlApiBase)
+	nrlDataService := services.NewNRLDataService(queries, ctx)
+	ratingService := services.NewRatingService(queries, ctx)
+
+	// Weight the stored rounds with the points weights in config
+	if updated, err := nrlDataService.RefreshRoundPointsWeights(); err != nil {
+		log.Printf("Error refreshing round points weights: %v", err)
+	} else if updated > 0 {
+		log.Printf("Updated the points weight of %d rounds", updated)
+	}
+
+	// Locate the stored venues with the venue locations in config
+	if linked, err := nrlDataService.RefreshVenues(); err != nil {
+		log.Printf("Error refreshing venues: %v", err)
+	} else if linked > 0 {
+		log.Printf("Linked %d fixtures to their venues", linked)
+	}
+
+	imported, skipped, failed := 0, 0, 0
+	for _, competitionID := range competitionIDs {
+		for season := firstSeason; season <= lastSeason; season++ {
+			for round := firstRound; round <= lastRound; round++ {
+				if ctx.Err() != nil {
+					log.Printf("Backfill interrupted after %d rounds imported, run again to resume", imported)
+					return ctx.Err()
+				}
+
+				if !*forceFlag {
+					if _, err := queries.GetBackfillProgress(ctx, db.GetBackfillProgressParams{
+						CompetitionID: competitionID,
+						Season:        int32(season),
+						RoundNumber:   int32(round),
+					}); err == nil {
+						skipped++
+						continue
+					}
+				}
+
+				draw, err := nrlService.FetchDraw(competitionID, round, season)
+				if err != nil {
+					log.Printf("Error fetching competition %d season %d round %d: %v", competitionID, season, round, err)
+					failed++
+					time.Sleep(*delayFlag)
+					continue
+				}
+
+				// The draw falls back to another round once we are past the end of
+				// the season, so stop at the first round that is not the one asked for.
+				fixtures := draw.Fixtures
+				if !inRound(fixtures, round) {
+					log.Printf("Competition %d season %d has no round %d, moving on", competitionID, season, round)
+					break
+				}
+
+				stored, complete := storeRound(nrlDataService, fixtures)
+				if err := nrlDataService.StoreRoundByes(competitionID, *draw); err != nil {
+					log.Printf("Error storing byes for competition %d season %d round %d: %v", competitionID, season, round, err)
+					complete = false
+				}
+				if complete && utils.HasLadder(int(competitionID)) {
+					time.Sleep(*delayFlag)
+					if err := storeLadder(nrlService, nrlDataService, competitionID, season, round); err != nil {
+						log.Printf("Error storing ladder for competition %d season %d round %d: %v", competitionID, season, round, err)
+						complete = false
+					}
+				}
+				if complete {
+					_, err := queries.MarkBackfillRoundComplete(ctx, db.MarkBackfillRoundCompleteParams{
+						CompetitionID: competitionID,
+						Season:        int32(season),
+						RoundNumber:   int32(round),
+						Fixtures:      int32(stored),
+					})
+					if err != nil {
+						log.Printf("Error recording progress for competition %d season %d round %d: %v", competitionID, season, round, err)
+					}
+					imported++
+				} else {
+					failed++
+				}
+
+				log.Printf("Competition %d season %d round %d: stored %d of %d fixtures", competitionID, season, round, stored, len(fixtures))
+				time.Sleep(*delayFlag)
+			}
+		}
+
+		// Replay the imported results into the team ratings
+		if err := ratingService.UpdateRatings(competitionID); err != nil {
+			log.Printf("Error updating ratings for competition %d: %v", competitionID, err)
+		}
+	}
+
+	log.Printf("Backfill finished: %d rounds imported, %d already complete, %d incomplete or failed", imported, skipped, failed)
+	return nil
+}
+
+// storeRound stores each fixture of a round and reports how many were stored and
+// whether the round is complete. A round is only complete once every fixture has
+// been stored with a final result, so rounds still in progress are retried.
+func storeRound(dataService *services.NRLDataService, fixtures []models.NRLFixture) (int, bool) {
+	stored, complete := 0, true
+	for _, fixture := range fixtures {
+		// Historical rounds must never replace the live current round.
+		fixture.IsCurrentRound = false
+
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			log.Printf("Error storing fixture ID %s: %v", fixture.ID, err)
+			complete = false
+			continue
+		}
+		stored++
+
+		if fixture.MatchState != config.MatchStateFullTime {
+			complete = false
+		}
+	}
+
+	return stored, complete
+}
+
+// storeLadder imports the ladder as at a round and logs any differences from the
+// ladder recalculated from the stored results. Ladders are only published for
+// regular season rounds, so a ladder for a different round is ignored.
+func storeLadder(nrlService *services.NRLService, dataService *services.NRLDataService, competitionID int64, season, round int) error {
+	ladder, err := nrlService.FetchLadder(competitionID, round, season)
+	if err != nil {
+		return err
+	}
+
+	if ladder.SelectedSeasonID != season || ladder.SelectedRoundID != round {
+		return nil
+	}
+
+	if err := dataService.StoreLadder(competitionID, *ladder); err != nil {
+		return err
+	}
+
+	discrepancies, err := dataService.CheckLadder(competitionID, int32(season), int32(round))
+	if err != nil {
+		return err
+	}
+
+	for _, discrepancy := range discrepancies {
+		log.Printf("Ladder discrepancy for competition %d season %d round %d: %s", competitionID, season, round, discrepancy)
+	}
+
+	return nil
+}
+
+// inRound reports whether the fetched fixtures belong to the requested round.
+func inRound(fixtures []models.NRLFixture, round int) bool {
+	if len(fixtures) == 0 {
+		return false
+	}
+
+	for _, fixture := range fixtures {
+		if len(fixture.ID) < 10 {
+			return false
+		}
+		if _, _, fixtureRound, _ := utils.ParseMatchID(fixture.ID); fixtureRound != round {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseCompetitions parses a comma separated list of competition IDs.
+func parseCompetitions(value string) ([]int64, error) {
+	var competitionIDs []int64
+	for _, part := range strings.Split(value, ",") {
+		competitionID, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid competition ID %q", part)
+		}
+		competitionIDs = append(competitionIDs, competitionID)
+	}
+
+	return competitionIDs, nil
+}
+
+// parseRange parses either a single number (e.g. 2024) or an inclusive range
+// (e.g. 2020-2024).
+func parseRange(value string) (int, int, error) {
+	from, to, isRange := strings.Cut(value, "-")
+
+	first, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number %q", from)
+	}
+
+	if !isRange {
+		return first, first, nil
+	}
+
+	last, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number %q", to)
+	}
+
+	if last < first {
+		return 0, 0, fmt.Errorf("range %q ends before it starts", value)
+	}
+
+	return first, last, nil
+}
@@ -13,11 +13,10 @@ import (
 	"github.com/aussiebroadwan/tipping/backend/internal/db"
 	"github.com/aussiebroadwan/tipping/backend/internal/handlers"
 	"github.com/aussiebroadwan/tipping/backend/internal/services"
+	"github.com/aussiebroadwan/tipping/backend/internal/utils"
 
 	_ "github.com/aussiebroadwan/tipping/backend/docs"
 	httpSwagger "github.com/swaggo/http-swagger"
-
-	"github.com/jackc/pgx/v5"
 )
 
 var (
@@ -32,23 +31,12 @@ func init() {
 	lg = slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
 	// Check for required environment variables
-	requiredDbEnvVars := []string{
-		"DB_HOST",
-		"DB_PORT",
-		"DB_USER",
-		"DB_PASSWORD",
-		"DB_NAME",
-	}
-
-	numMissing := 0
-	for _, envVar := range requiredDbEnvVars {
-		if os.Getenv(envVar) == "" {
-			lg.Error("Environment variable " + envVar + " is required")
-			numMissing++
-		}
+	missing := utils.MissingDBEnvVars()
+	for _, envVar := range missing {
+		lg.Error("Environment variable " + envVar + " is required")
 	}
 
-	if numMissing > 0 {
+	if len(missing) > 0 {
 		os.Exit(1)
 	}
 
@@ -63,23 +51,6 @@ func init() {
 	}
 }
 
-func connect(ctx context.Context) (*pgx.Conn, error) {
-	psqlInfo := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_PORT"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-	)
-
-	conn, err := pgx.Connect(ctx, psqlInfo)
-	if err != nil {
-		return nil, err
-	}
-	return conn, nil
-}
-
 // @title Tipping API
 // @version 1.0
 // @description This is the API for the Tipping Application to interact with NRL data.
@@ -87,7 +58,7 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	conn, err := connect(ctx)
+	conn, err := utils.ConnectDB(ctx)
 	if err != nil {
 		lg.Error(fmt.Sprintf("Failed to connect to database: %s", err.Error()))
 		os.Exit(1)
@@ -97,16 +68,32 @@ func main() {
 	queries := db.New(conn)
 
 	// Initialize services
-	nrlService := services.NewNRLService(os.Getenv("NRL_API_BASE_URL"))
+	nrlCacheService := services.NewNRLCacheService(queries, ctx)
+	nrlService := services.NewCachedNRLService(os.Getenv("NRL_API_BASE_URL"), nrlCacheService)
 	nrlDataService := services.NewNRLDataService(queries, ctx)
+	ratingService := services.NewRatingService(queries, ctx)
 	apiDataService := services.NewAPIDataService(queries, ctx)
 
+	// Weight the stored rounds with the points weights in config
+	if updated, err := nrlDataService.RefreshRoundPointsWeights(); err != nil {
+		log.Printf("Error refreshing round points weights: %v", err)
+	} else if updated > 0 {
+		log.Printf("Updated the points weight of %d rounds", updated)
+	}
+
+	// Locate the stored venues with the venue locations in config
+	if linked, err := nrlDataService.RefreshVenues(); err != nil {
+		log.Printf("Error refreshing venues: %v", err)
+	} else if linked > 0 {
+		log.Printf("Linked %d fixtures to their venues", linked)
+	}
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("GET /health", GetHealth)
 	mux.HandleFunc("GET /swagger/", httpSwagger.Handler(
 		httpSwagger.URL(apiBase+"/swagger/doc.json"),
 	))
-	handlers.RegisterRoutes(mux, apiDataService)
+	handlers.RegisterRoutes(mux, apiDataService, nrlCacheService)
 	go http.ListenAndServe(":8080", mux)
 
 	// Define the competition IDs you want to fetch data for
@@ -118,7 +105,7 @@ func main() {
 	}
 
 	// Initialize and start the scheduled service
-	scheduledService := services.NewNRLScheduledService(nrlService, nrlDataService, competitionIDs)
+	scheduledService := services.NewNRLScheduledService(nrlService, nrlDataService, ratingService, competitionIDs)
 	go scheduledService.Start(ctx)
 
 	// Signal handler for graceful shutdown
@@ -8,6 +8,69 @@ const (
 	// TBD: Add more states as needed
 )
 
+// Round Types
+const (
+	RoundTypeRegular = "Regular" // Regular season round (e.g., Round 1)
+	RoundTypeFinals  = "Finals"  // Finals series week (e.g., Finals Week 1)
+	RoundTypeOrigin  = "Origin"  // State of Origin game (e.g., Game 1)
+
+	RoundTitleGrandFinal = "Grand Final" // Title of the last week of the finals series
+)
+
+// Round Points Weights, multiplied against the points of tips on a round's fixtures
+const (
+	PointsWeightRegular    = 1 // Regular season rounds and Origin games
+	PointsWeightFinals     = 2 // Finals series weeks before the grand final
+	PointsWeightGrandFinal = 3 // The grand final
+)
+
+// Result Corrections
+const (
+	CorrectionRounds = 2 // Number of rounds before the current round refetched on each scheduled fetch to pick up corrected results
+)
+
+// Team List Changes
+const (
+	TeamListChangeIn       = "In"       // Player was added to the team list
+	TeamListChangeOut      = "Out"      // Player was removed from the team list
+	TeamListChangePosition = "Position" // Player was moved to a different jersey number or position
+)
+
+// Ladder Points
+const (
+	LadderPointsWin  = 2 // Competition points for a win
+	LadderPointsDraw = 1 // Competition points for a draw
+	LadderPointsBye  = 2 // Competition points for a bye
+)
+
+// Form Results
+const (
+	FormMatches = 5   // Number of recent results included in the form of a team
+	FormWin     = "W" // Result of a win in the form of a team
+	FormLoss    = "L" // Result of a loss in the form of a team
+	FormDraw    = "D" // Result of a draw in the form of a team
+)
+
+// Head to Head
+const (
+	HeadToHeadMatches = 5 // Number of past meetings included with the scores in a head-to-head
+)
+
+// Rating Model
+const (
+	RatingInitial         = 1500.0 // Elo rating of a team before its first match
+	RatingK               = 20.0   // Maximum rating change from a single match, before the margin multiplier
+	RatingHomeAdvantage   = 50.0   // Rating points added to the home team when predicting a match
+	RatingHomeGroundShare = 0.2    // Minimum proportion of a team's earlier home fixtures played at a venue for the home advantage to apply there
+	RatingSeasonCarryOver = 0.75   // Proportion of a team's rating above or below the initial rating kept into a new season
+	RatingPointsPerMargin = 25.0   // Rating difference that predicts a winning margin of one point
+)
+
+// Betting Market
+const (
+	CloseGameProbability = 0.55 // Implied probability of the favourite below which a match is a close game
+)
+
 // Competition IDs
 const (
 	CompetitionNRL                 = 111 // National Rugby League
@@ -21,3 +84,88 @@ const (
 	CheckInterval     = 5 * 60  // Interval in seconds to recheck match status if not "FullTime"
 	InitialCheckDelay = 80 * 60 // Delay in seconds for initial check after kickoff
 )
+
+// TeamTheme holds the details of a team which the NRL API does not provide.
+type TeamTheme struct {
+	Code            string // Short code for the team
+	PrimaryColour   string // Primary theme colour as a hex code
+	SecondaryColour string // Secondary theme colour as a hex code
+}
+
+// TeamThemes maps the theme key of a team in the NRL API (e.g., cowboys) to its
+// details. NRL and NRLW teams of the same club share a theme key.
+var TeamThemes = map[string]TeamTheme{
+	"broncos":      {Code: "BRI", PrimaryColour: "#760135", SecondaryColour: "#FBB03F"},
+	"bulldogs":     {Code: "CBY", PrimaryColour: "#0054A4", SecondaryColour: "#FFFFFF"},
+	"cowboys":      {Code: "NQL", PrimaryColour: "#002B5C", SecondaryColour: "#FFDD02"},
+	"dolphins":     {Code: "DOL", PrimaryColour: "#E0001B", SecondaryColour: "#FDB913"},
+	"dragons":      {Code: "STG", PrimaryColour: "#E2231A", SecondaryColour: "#FFFFFF"},
+	"eels":         {Code: "PAR", PrimaryColour: "#006EB5", SecondaryColour: "#FFD100"},
+	"knights":      {Code: "NEW", PrimaryColour: "#003B73", SecondaryColour: "#EE3524"},
+	"panthers":     {Code: "PEN", PrimaryColour: "#2A2A2A", SecondaryColour: "#E0004D"},
+	"rabbitohs":    {Code: "SOU", PrimaryColour: "#006A3E", SecondaryColour: "#E2231A"},
+	"raiders":      {Code: "CAN", PrimaryColour: "#8CC63F", SecondaryColour: "#003A70"},
+	"roosters":     {Code: "SYD", PrimaryColour: "#002B5C", SecondaryColour: "#E2231A"},
+	"sea-eagles":   {Code: "MAN", PrimaryColour: "#6F163D", SecondaryColour: "#FFFFFF"},
+	"sharks":       {Code: "CRO", PrimaryColour: "#00A9D8", SecondaryColour: "#000000"},
+	"storm":        {Code: "MEL", PrimaryColour: "#632390", SecondaryColour: "#002B5C"},
+	"titans":       {Code: "GLD", PrimaryColour: "#009FDF", SecondaryColour: "#FBB03F"},
+	"warriors":     {Code: "WAR", PrimaryColour: "#231F20", SecondaryColour: "#00A3E0"},
+	"wests-tigers": {Code: "WST", PrimaryColour: "#F68B1F", SecondaryColour: "#000000"},
+}
+
+// VenueLocation holds where a venue city is, which the NRL API does not provide.
+type VenueLocation struct {
+	State    string // State or territory, empty outside Australia and the United States
+	Country  string // Country the city is in
+	Timezone string // IANA timezone of the city
+}
+
+// VenueLocations maps the venue city of a fixture in the NRL API (e.g., Townsville)
+// to its location.
+var VenueLocations = map[string]VenueLocation{
+	"Sydney":         {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Penrith":        {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Parramatta":     {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Campbelltown":   {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Kogarah":        {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Leichhardt":     {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Cronulla":       {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Brookvale":      {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Wollongong":     {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Gosford":        {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Newcastle":      {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Bathurst":       {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Mudgee":         {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Tamworth":       {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Wagga Wagga":    {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Dubbo":          {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Albury":         {State: "NSW", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Canberra":       {State: "ACT", Country: "Australia", Timezone: "Australia/Sydney"},
+	"Brisbane":       {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Gold Coast":     {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Townsville":     {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Cairns":         {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Mackay":         {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Toowoomba":      {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Sunshine Coast": {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Redcliffe":      {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Rockhampton":    {State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"},
+	"Melbourne":      {State: "VIC", Country: "Australia", Timezone: "Australia/Melbourne"},
+	"Adelaide":       {State: "SA", Country: "Australia", Timezone: "Australia/Adelaide"},
+	"Perth":          {State: "WA", Country: "Australia", Timezone: "Australia/Perth"},
+	"Darwin":         {State: "NT", Country: "Australia", Timezone: "Australia/Darwin"},
+	"Hobart":         {State: "TAS", Country: "Australia", Timezone: "Australia/Hobart"},
+	"Auckland":       {Country: "New Zealand", Timezone: "Pacific/Auckland"},
+	"Christchurch":   {Country: "New Zealand", Timezone: "Pacific/Auckland"},
+	"Wellington":     {Country: "New Zealand", Timezone: "Pacific/Auckland"},
+	"Hamilton":       {Country: "New Zealand", Timezone: "Pacific/Auckland"},
+	"New Plymouth":   {Country: "New Zealand", Timezone: "Pacific/Auckland"},
+	"Port Moresby":   {Country: "Papua New Guinea", Timezone: "Pacific/Port_Moresby"},
+	"Las Vegas":      {State: "NV", Country: "United States", Timezone: "America/Los_Angeles"},
+}
+
+// VenueLocationsByName overrides the location of a venue (e.g., Optus Stadium)
+// whose venue city is missing from VenueLocations or maps to the wrong location.
+// Changes are applied to the stored venues when the server or backfill starts.
+var VenueLocationsByName = map[string]VenueLocation{}
@@ -38,6 +38,218 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/competitions/{competition_id}/calibration": {
+            "get": {
+                "description": "Get the Brier score and accuracy of the predictions of the completed matches of a competition season, compared against the probabilities implied by the bookmaker odds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Retrieve the calibration of the predictions of a competition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 111,
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 2024,
+                        "description": "Season, defaults to the current season",
+                        "name": "season",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APICalibration"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id or season"
+                    }
+                }
+            }
+        },
+        "/api/v1/competitions/{competition_id}/ladder": {
+            "get": {
+                "description": "Get the ladder of a competition season as at a round, ordered by position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Retrieve the ladder of a competition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 111,
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 22,
+                        "description": "Round number, defaults to the latest round with a ladder",
+                        "name": "round",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 2024,
+                        "description": "Season, defaults to the current season",
+                        "name": "season",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.APILadderEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id, round or season"
+                    }
+                }
+            }
+        },
+        "/api/v1/competitions/{competition_id}/ratings": {
+            "get": {
+                "description": "Get the Elo rating of each team in a competition, highest rated first, calculated by replaying the stored results",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Retrieve the team ratings of a competition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 111,
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.APITeamRating"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id"
+                    }
+                }
+            }
+        },
+        "/api/v1/competitions/{competition_id}/rounds": {
+            "get": {
+                "description": "Get the rounds of a competition season, including their kickoff window and the teams on the bye",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Retrieve the rounds of a competition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 111,
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 2024,
+                        "description": "Season, defaults to the current season",
+                        "name": "season",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.APIRound"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id or season"
+                    }
+                }
+            }
+        },
+        "/api/v1/competitions/{competition_id}/series": {
+            "get": {
+                "description": "Get the series score, the games played and remaining, whether the series has been decided and which team holds the shield",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Retrieve the state of a State of Origin series",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 116,
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 2024,
+                        "description": "Season, defaults to the current season",
+                        "name": "season",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APISeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id or season, or the competition is not played as a series"
+                    },
+                    "404": {
+                        "description": "Series not found"
+                    }
+                }
+            }
+        },
         "/api/v1/fixtures": {
             "get": {
                 "description": "Get all fixtures",
@@ -48,6 +260,22 @@ const docTemplate = `{
                     "fixtures"
                 ],
                 "summary": "Retrieve a list of all fixtures",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 2024,
+                        "description": "Season, defaults to the current season of each competition",
+                        "name": "season",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Pacific/Auckland",
+                        "description": "IANA timezone to also return kickoff times in",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -57,6 +285,9 @@ const docTemplate = `{
                                 "$ref": "#/definitions/models.APIFixture"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Invalid season or tz"
                     }
                 }
             }
@@ -81,11 +312,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "example": 1,
-                        "description": "Round number",
+                        "type": "string",
+                        "example": "1",
+                        "description": "Round number, round slug (e.g. finals-week-1 or grand-final), or all",
                         "name": "round",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 2024,
+                        "description": "Season, defaults to the current season",
+                        "name": "season",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Pacific/Auckland",
+                        "description": "IANA timezone to also return kickoff times in",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -99,7 +344,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid competition_id"
+                        "description": "Invalid competition_id, season or tz"
                     }
                 }
             }
@@ -130,6 +375,13 @@ const docTemplate = `{
                         "name": "match_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "Pacific/Auckland",
+                        "description": "IANA timezone to also return kickoff times in",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -140,40 +392,350 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid competition_id or match_id, or Fixture does not belong to the specified competition"
+                        "description": "Invalid competition_id, match_id or tz, or Fixture does not belong to the specified competition"
                     },
                     "500": {
                         "description": "Internal server error"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.APICompetition": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "description": "Unique identifier for the competition",
-                    "type": "integer",
-                    "example": 111
-                },
-                "name": {
-                    "description": "Name of the competition",
-                    "type": "string",
-                    "example": "NRL"
+        },
+        "/api/v1/fixtures/{competition_id}/{match_id}/stats": {
+            "get": {
+                "description": "Get the scoring timeline and team statistics of a match, available once the match has finished.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fixtures"
+                ],
+                "summary": "Retrieve match statistics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 111,
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20241112210,
+                        "description": "Match ID",
+                        "name": "match_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIMatchStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id or match_id, or Fixture does not belong to the specified competition"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
                 }
             }
         },
-        "models.APIFixture": {
-            "type": "object",
-            "properties": {
-                "away_team": {
-                    "description": "Away team details",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.APITeam"
-                        }
+        "/api/v1/teams": {
+            "get": {
+                "description": "Get all teams with the competitions and seasons they have played in, optionally limited to a competition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Retrieve a list of all teams",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 111,
+                        "description": "Only include teams that have played in the competition",
+                        "name": "competition_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.APITeamDetails"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition_id"
+                    }
+                }
+            }
+        },
+        "/api/v1/teams/{team_id}": {
+            "get": {
+                "description": "Get the full record of a team, including the competitions it has played in and its home grounds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Retrieve a team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 500012,
+                        "description": "Team ID",
+                        "name": "team_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APITeamDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team_id"
+                    },
+                    "404": {
+                        "description": "Team not found"
+                    }
+                }
+            }
+        },
+        "/api/v1/teams/{team_id}/versus/{opponent_id}": {
+            "get": {
+                "description": "Get the record of a team against an opponent from their stored results, their record at the venue of their next meeting and the scores of their last meetings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Retrieve the head-to-head history of two teams",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 500012,
+                        "description": "Team ID",
+                        "name": "team_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 500021,
+                        "description": "Opponent team ID",
+                        "name": "opponent_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIHeadToHead"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team_id or opponent_id"
+                    },
+                    "404": {
+                        "description": "Team not found"
+                    }
+                }
+            }
+        },
+        "/metrics/nrl-cache": {
+            "get": {
+                "description": "Get the number of NRL API requests since the server started, and how many were served from the response cache",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "metrics"
+                ],
+                "summary": "Retrieve the NRL API response cache metrics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APICacheStats"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.APIBye": {
+            "type": "object",
+            "properties": {
+                "nickname": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Dolphins"
+                },
+                "team_id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500723
+                }
+            }
+        },
+        "models.APICacheStats": {
+            "type": "object",
+            "properties": {
+                "hit_rate": {
+                    "description": "Fraction of fetches that were unchanged",
+                    "type": "number",
+                    "example": 0.8
+                },
+                "hits": {
+                    "description": "Documents that were unchanged since the last fetch",
+                    "type": "integer",
+                    "example": 96
+                },
+                "misses": {
+                    "description": "Documents that were new or had changed",
+                    "type": "integer",
+                    "example": 24
+                },
+                "requests": {
+                    "description": "Number of documents fetched since startup",
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "models.APICalibration": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "description": "Results grouped by predicted home win probability",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APICalibrationBucket"
+                    }
+                },
+                "competition_id": {
+                    "description": "The competition ID",
+                    "type": "integer",
+                    "example": 111
+                },
+                "model": {
+                    "description": "Predictions of every completed match",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APICalibrationScore"
+                        }
+                    ]
+                },
+                "model_with_odds": {
+                    "description": "Predictions of only the completed matches with odds",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APICalibrationScore"
+                        }
+                    ]
+                },
+                "odds": {
+                    "description": "Probabilities implied by the odds of the completed matches with odds",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APICalibrationScore"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.APICalibrationBucket": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "Lowest predicted home win probability in the bucket",
+                    "type": "number",
+                    "example": 0.6
+                },
+                "matches": {
+                    "description": "Number of matches in the bucket",
+                    "type": "integer",
+                    "example": 31
+                },
+                "observed": {
+                    "description": "Proportion of matches won by the home team, counting draws as half",
+                    "type": "number",
+                    "example": 0.613
+                },
+                "predicted": {
+                    "description": "Mean predicted home win probability",
+                    "type": "number",
+                    "example": 0.65
+                },
+                "to": {
+                    "description": "Highest predicted home win probability in the bucket, exclusive",
+                    "type": "number",
+                    "example": 0.7
+                }
+            }
+        },
+        "models.APICalibrationScore": {
+            "type": "object",
+            "properties": {
+                "accuracy": {
+                    "description": "Proportion of matches won by the favourite",
+                    "type": "number",
+                    "example": 0.642
+                },
+                "brier_score": {
+                    "description": "Mean squared error of the home win probabilities, lower is better",
+                    "type": "number",
+                    "example": 0.213
+                },
+                "matches": {
+                    "description": "Number of matches scored",
+                    "type": "integer",
+                    "example": 204
+                }
+            }
+        },
+        "models.APICompetition": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Unique identifier for the competition",
+                    "type": "integer",
+                    "example": 111
+                },
+                "name": {
+                    "description": "Name of the competition",
+                    "type": "string",
+                    "example": "NRL"
+                }
+            }
+        },
+        "models.APIFixture": {
+            "type": "object",
+            "properties": {
+                "away_team": {
+                    "description": "Away team details",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APITeam"
+                        }
                     ]
                 },
                 "competition_id": {
@@ -181,6 +743,11 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 111
                 },
+                "head_to_head_url": {
+                    "description": "Path of the past meetings of the teams, only included with match details",
+                    "type": "string",
+                    "example": "/api/v1/teams/500012/versus/500021"
+                },
                 "home_team": {
                     "description": "Home team details",
                     "allOf": [
@@ -199,16 +766,52 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2024-08-24T01:00:00Z"
                 },
+                "local_kick_off_time": {
+                    "description": "Kickoff time in the timezone of the venue",
+                    "type": "string",
+                    "example": "2024-08-24T11:00:00+10:00"
+                },
+                "market": {
+                    "description": "Probabilities implied by the odds, only included once both teams have odds",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIMarket"
+                        }
+                    ]
+                },
                 "match_state": {
                     "description": "Current state of the match",
                     "type": "string",
                     "example": "FullTime"
                 },
+                "points_weight": {
+                    "description": "Multiplier applied to the points of tips on the match",
+                    "type": "integer",
+                    "example": 1
+                },
+                "prediction": {
+                    "description": "Prediction of the match from the team ratings, made before kickoff",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIPrediction"
+                        }
+                    ]
+                },
+                "round_number": {
+                    "description": "Number of the round within the season",
+                    "type": "integer",
+                    "example": 22
+                },
                 "round_title": {
                     "description": "The title of the round",
                     "type": "string",
                     "example": "Round 22"
                 },
+                "season": {
+                    "description": "The season this fixture belongs to",
+                    "type": "integer",
+                    "example": 2024
+                },
                 "venue": {
                     "description": "Venue of the match",
                     "type": "string",
@@ -218,31 +821,975 @@ const docTemplate = `{
                     "description": "City where the venue is located",
                     "type": "string",
                     "example": "Sydney"
+                },
+                "venue_timezone": {
+                    "description": "IANA timezone of the venue",
+                    "type": "string",
+                    "example": "Australia/Sydney"
+                },
+                "viewer_kick_off_time": {
+                    "description": "Kickoff time in the timezone requested with the tz query parameter",
+                    "type": "string",
+                    "example": "2024-08-24T13:00:00+12:00"
                 }
             }
         },
-        "models.APITeam": {
+        "models.APIFormResult": {
             "type": "object",
             "properties": {
-                "form": {
-                    "description": "Recent form of the team",
+                "fixture_id": {
+                    "description": "Unique identifier for the fixture",
+                    "type": "integer",
+                    "example": 20241112510
+                },
+                "home": {
+                    "description": "Whether the team played at home",
+                    "type": "boolean",
+                    "example": true
+                },
+                "kick_off_time": {
+                    "description": "Kickoff time of the match",
                     "type": "string",
-                    "example": "WLWWL"
+                    "example": "2024-08-17T09:35:00Z"
                 },
-                "nickname": {
-                    "description": "Nickname of the team",
+                "opponent": {
+                    "description": "Nickname of the opponent",
                     "type": "string",
-                    "example": "Cowboys"
+                    "example": "Storm"
                 },
-                "odds": {
-                    "description": "Odds for the team to win",
-                    "type": "number",
-                    "example": 3.42
+                "opponent_id": {
+                    "description": "Unique identifier for the opponent",
+                    "type": "integer",
+                    "example": 500021
+                },
+                "opponent_score": {
+                    "description": "Points scored by the opponent",
+                    "type": "integer",
+                    "example": 4
+                },
+                "result": {
+                    "description": "W for a win, L for a loss or D for a draw",
+                    "type": "string",
+                    "example": "W"
                 },
                 "score": {
-                    "description": "Final score of the team",
+                    "description": "Points scored by the team",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "models.APIFormSplit": {
+            "type": "object",
+            "properties": {
+                "draws": {
+                    "description": "Number of draws",
+                    "type": "integer",
+                    "example": 0
+                },
+                "losses": {
+                    "description": "Number of losses",
+                    "type": "integer",
+                    "example": 1
+                },
+                "played": {
+                    "description": "Number of matches played",
+                    "type": "integer",
+                    "example": 3
+                },
+                "points_against": {
+                    "description": "Points conceded",
                     "type": "integer",
                     "example": 40
+                },
+                "points_for": {
+                    "description": "Points scored",
+                    "type": "integer",
+                    "example": 70
+                },
+                "wins": {
+                    "description": "Number of wins",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.APIHeadToHead": {
+            "type": "object",
+            "properties": {
+                "at_venue": {
+                    "description": "Record of the meetings at the venue of the next meeting",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIHeadToHeadRecord"
+                        }
+                    ]
+                },
+                "last_meetings": {
+                    "description": "Most recent meetings first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIHeadToHeadResult"
+                    }
+                },
+                "next_fixture": {
+                    "description": "Next meeting of the teams, if one has been scheduled",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIHeadToHeadFixture"
+                        }
+                    ]
+                },
+                "opponent": {
+                    "description": "Nickname of the opponent",
+                    "type": "string",
+                    "example": "Storm"
+                },
+                "opponent_id": {
+                    "description": "Unique identifier for the opponent",
+                    "type": "integer",
+                    "example": 500021
+                },
+                "overall": {
+                    "description": "Record of every meeting",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIHeadToHeadRecord"
+                        }
+                    ]
+                },
+                "team": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Cowboys"
+                },
+                "team_id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500012
+                }
+            }
+        },
+        "models.APIHeadToHeadFixture": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "description": "The competition ID this fixture belongs to",
+                    "type": "integer",
+                    "example": 111
+                },
+                "id": {
+                    "description": "Unique identifier for the fixture",
+                    "type": "integer",
+                    "example": 20241112610
+                },
+                "kick_off_time": {
+                    "description": "Kickoff time of the match",
+                    "type": "string",
+                    "example": "2024-08-27T01:16:09Z"
+                },
+                "round_title": {
+                    "description": "The title of the round",
+                    "type": "string",
+                    "example": "Round 26"
+                },
+                "venue": {
+                    "description": "Venue of the match",
+                    "type": "string",
+                    "example": "Queensland Country Bank Stadium"
+                },
+                "venue_city": {
+                    "description": "City where the venue is located",
+                    "type": "string",
+                    "example": "Townsville"
+                }
+            }
+        },
+        "models.APIHeadToHeadRecord": {
+            "type": "object",
+            "properties": {
+                "average_margin": {
+                    "description": "Average margin of the team, negative when the opponent has scored more",
+                    "type": "number",
+                    "example": -3.5
+                },
+                "draws": {
+                    "description": "Number of draws",
+                    "type": "integer",
+                    "example": 1
+                },
+                "opponent_wins": {
+                    "description": "Number of wins for the opponent",
+                    "type": "integer",
+                    "example": 5
+                },
+                "played": {
+                    "description": "Number of meetings",
+                    "type": "integer",
+                    "example": 10
+                },
+                "wins": {
+                    "description": "Number of wins for the team",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "models.APIHeadToHeadResult": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "description": "The competition ID this fixture belongs to",
+                    "type": "integer",
+                    "example": 111
+                },
+                "fixture_id": {
+                    "description": "Unique identifier for the fixture",
+                    "type": "integer",
+                    "example": 20241110110
+                },
+                "home": {
+                    "description": "Whether the team played at home",
+                    "type": "boolean",
+                    "example": false
+                },
+                "kick_off_time": {
+                    "description": "Kickoff time of the match",
+                    "type": "string",
+                    "example": "2024-03-08T09:00:00Z"
+                },
+                "opponent_score": {
+                    "description": "Points scored by the opponent",
+                    "type": "integer",
+                    "example": 30
+                },
+                "result": {
+                    "description": "W for a win, L for a loss or D for a draw",
+                    "type": "string",
+                    "example": "L"
+                },
+                "round_title": {
+                    "description": "The title of the round",
+                    "type": "string",
+                    "example": "Round 1"
+                },
+                "score": {
+                    "description": "Points scored by the team",
+                    "type": "integer",
+                    "example": 12
+                },
+                "season": {
+                    "description": "The season of the meeting",
+                    "type": "integer",
+                    "example": 2024
+                },
+                "venue": {
+                    "description": "Venue of the match",
+                    "type": "string",
+                    "example": "AAMI Park"
+                },
+                "venue_city": {
+                    "description": "City where the venue is located",
+                    "type": "string",
+                    "example": "Melbourne"
+                }
+            }
+        },
+        "models.APIHomeGround": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City where the venue is located",
+                    "type": "string",
+                    "example": "Townsville"
+                },
+                "matches": {
+                    "description": "Number of home matches played at the venue",
+                    "type": "integer",
+                    "example": 12
+                },
+                "venue": {
+                    "description": "Name of the venue",
+                    "type": "string",
+                    "example": "Queensland Country Bank Stadium"
+                }
+            }
+        },
+        "models.APILadderEntry": {
+            "type": "object",
+            "properties": {
+                "byes": {
+                    "description": "Number of byes",
+                    "type": "integer",
+                    "example": 3
+                },
+                "draws": {
+                    "description": "Number of matches drawn",
+                    "type": "integer",
+                    "example": 0
+                },
+                "losses": {
+                    "description": "Number of matches lost",
+                    "type": "integer",
+                    "example": 5
+                },
+                "nickname": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Storm"
+                },
+                "played": {
+                    "description": "Number of matches played",
+                    "type": "integer",
+                    "example": 24
+                },
+                "points": {
+                    "description": "Competition points",
+                    "type": "integer",
+                    "example": 44
+                },
+                "points_against": {
+                    "description": "Total points scored against the team",
+                    "type": "integer",
+                    "example": 438
+                },
+                "points_difference": {
+                    "description": "Points for minus points against",
+                    "type": "integer",
+                    "example": 220
+                },
+                "points_for": {
+                    "description": "Total points scored by the team",
+                    "type": "integer",
+                    "example": 658
+                },
+                "position": {
+                    "description": "Position of the team on the ladder",
+                    "type": "integer",
+                    "example": 1
+                },
+                "team_id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500021
+                },
+                "wins": {
+                    "description": "Number of matches won",
+                    "type": "integer",
+                    "example": 19
+                }
+            }
+        },
+        "models.APIMarket": {
+            "type": "object",
+            "properties": {
+                "away_probability": {
+                    "description": "Probability of the away team winning implied by the odds",
+                    "type": "number",
+                    "example": 0.22
+                },
+                "close_game": {
+                    "description": "Whether neither team is a clear favourite",
+                    "type": "boolean",
+                    "example": false
+                },
+                "favourite_team_id": {
+                    "description": "Team with the higher implied probability, not set when the odds are equal",
+                    "type": "integer",
+                    "example": 500012
+                },
+                "home_drift": {
+                    "description": "Change in the home probability since the odds were first recorded, positive when the market has moved towards the home team",
+                    "type": "number",
+                    "example": 0.04
+                },
+                "home_probability": {
+                    "description": "Probability of the home team winning implied by the odds",
+                    "type": "number",
+                    "example": 0.78
+                },
+                "opening_home_probability": {
+                    "description": "Implied probability of the home team winning when the odds were first recorded",
+                    "type": "number",
+                    "example": 0.74
+                },
+                "overround": {
+                    "description": "Bookmaker margin removed from the implied probabilities",
+                    "type": "number",
+                    "example": 0.048
+                },
+                "underdog_team_id": {
+                    "description": "Team with the lower implied probability, not set when the odds are equal",
+                    "type": "integer",
+                    "example": 500021
+                }
+            }
+        },
+        "models.APIMatchEvent": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "description": "Score of the away team after the event",
+                    "type": "integer",
+                    "example": 0
+                },
+                "game_seconds": {
+                    "description": "Seconds into the match the event occurred",
+                    "type": "integer",
+                    "example": 452
+                },
+                "home_score": {
+                    "description": "Score of the home team after the event",
+                    "type": "integer",
+                    "example": 4
+                },
+                "player": {
+                    "description": "Name of the player involved, if known",
+                    "type": "string",
+                    "example": "Jason Taumalolo"
+                },
+                "team_id": {
+                    "description": "Team of the event, if it belongs to a team",
+                    "type": "integer",
+                    "example": 500012
+                },
+                "title": {
+                    "description": "Description of the event",
+                    "type": "string",
+                    "example": "Try"
+                },
+                "type": {
+                    "description": "Type of the event (e.g., Try, Goal, SinBin)",
+                    "type": "string",
+                    "example": "Try"
+                }
+            }
+        },
+        "models.APIMatchStats": {
+            "type": "object",
+            "properties": {
+                "fixture_id": {
+                    "description": "Unique identifier for the fixture",
+                    "type": "integer",
+                    "example": 20241112210
+                },
+                "team_stats": {
+                    "description": "Statistics of both teams",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APITeamStat"
+                    }
+                },
+                "timeline": {
+                    "description": "Events of the match in the order they occurred",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIMatchEvent"
+                    }
+                }
+            }
+        },
+        "models.APIPlayer": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Unique identifier for the player",
+                    "type": "integer",
+                    "example": 504279
+                },
+                "name": {
+                    "description": "Full name of the player",
+                    "type": "string",
+                    "example": "Reuben Cotter"
+                },
+                "number": {
+                    "description": "Jersey number the player is named in",
+                    "type": "integer",
+                    "example": 13
+                },
+                "position": {
+                    "description": "Position the player is named in",
+                    "type": "string",
+                    "example": "Lock"
+                }
+            }
+        },
+        "models.APIPrediction": {
+            "type": "object",
+            "properties": {
+                "away_rating": {
+                    "description": "Rating of the away team before the match",
+                    "type": "number",
+                    "example": 1498.7
+                },
+                "away_win_probability": {
+                    "description": "Predicted probability of the away team winning",
+                    "type": "number",
+                    "example": 0.287
+                },
+                "home_rating": {
+                    "description": "Rating of the home team before the match",
+                    "type": "number",
+                    "example": 1562.3
+                },
+                "home_win_probability": {
+                    "description": "Predicted probability of the home team winning",
+                    "type": "number",
+                    "example": 0.713
+                },
+                "predicted_margin": {
+                    "description": "Predicted margin of the home team, negative when the away team is favoured",
+                    "type": "number",
+                    "example": 4.5
+                }
+            }
+        },
+        "models.APIRound": {
+            "type": "object",
+            "properties": {
+                "byes": {
+                    "description": "Teams on the bye for the round",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIBye"
+                    }
+                },
+                "finals_week": {
+                    "description": "Week of the finals series, only set for finals rounds",
+                    "type": "integer",
+                    "example": 1
+                },
+                "first_kick_off": {
+                    "description": "Kickoff time of the first match in the round",
+                    "type": "string",
+                    "example": "2024-08-29T09:50:00Z"
+                },
+                "last_kick_off": {
+                    "description": "Kickoff time of the last match in the round",
+                    "type": "string",
+                    "example": "2024-09-01T06:15:00Z"
+                },
+                "number": {
+                    "description": "Number of the round within the season",
+                    "type": "integer",
+                    "example": 26
+                },
+                "points_weight": {
+                    "description": "Multiplier applied to the points of tips in the round",
+                    "type": "integer",
+                    "example": 1
+                },
+                "slug": {
+                    "description": "URL friendly title, usable as the round of the fixtures endpoint",
+                    "type": "string",
+                    "example": "round-26"
+                },
+                "title": {
+                    "description": "The title of the round",
+                    "type": "string",
+                    "example": "Round 26"
+                },
+                "type": {
+                    "description": "Type of the round (Regular, Finals or Origin)",
+                    "type": "string",
+                    "example": "Regular"
+                }
+            }
+        },
+        "models.APISeries": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "description": "The competition ID",
+                    "type": "integer",
+                    "example": 116
+                },
+                "decided": {
+                    "description": "Whether the remaining games can no longer change the result of the series",
+                    "type": "boolean",
+                    "example": true
+                },
+                "draws": {
+                    "description": "Number of drawn games",
+                    "type": "integer",
+                    "example": 0
+                },
+                "games": {
+                    "description": "Games of the series in order of kickoff",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APISeriesGame"
+                    }
+                },
+                "games_played": {
+                    "description": "Number of completed games",
+                    "type": "integer",
+                    "example": 3
+                },
+                "games_remaining": {
+                    "description": "Number of games still to be played",
+                    "type": "integer",
+                    "example": 0
+                },
+                "score": {
+                    "description": "Wins of the leading team followed by wins of the other team",
+                    "type": "string",
+                    "example": "2-1"
+                },
+                "season": {
+                    "description": "The season of the series",
+                    "type": "integer",
+                    "example": 2024
+                },
+                "shield_holder_team_id": {
+                    "description": "Team holding the shield, the previous holder keeps it until the series is won",
+                    "type": "integer",
+                    "example": 500003
+                },
+                "shield_retained": {
+                    "description": "Whether the series has been decided and the previous holder kept the shield",
+                    "type": "boolean",
+                    "example": false
+                },
+                "teams": {
+                    "description": "Teams of the series, the leading team first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APISeriesTeam"
+                    }
+                },
+                "winner_team_id": {
+                    "description": "Team that has won the series, if it has been decided and was not drawn",
+                    "type": "integer",
+                    "example": 500003
+                }
+            }
+        },
+        "models.APISeriesGame": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "description": "Points scored by the away team, once the game has started",
+                    "type": "integer",
+                    "example": 38
+                },
+                "away_team_id": {
+                    "description": "Unique identifier for the away team",
+                    "type": "integer",
+                    "example": 500004
+                },
+                "fixture_id": {
+                    "description": "Unique identifier for the fixture",
+                    "type": "integer",
+                    "example": 20241160110
+                },
+                "home_score": {
+                    "description": "Points scored by the home team, once the game has started",
+                    "type": "integer",
+                    "example": 10
+                },
+                "home_team_id": {
+                    "description": "Unique identifier for the home team",
+                    "type": "integer",
+                    "example": 500003
+                },
+                "kick_off_time": {
+                    "description": "Kickoff time of the match",
+                    "type": "string",
+                    "example": "2024-06-05T10:05:00Z"
+                },
+                "match_state": {
+                    "description": "Current state of the match",
+                    "type": "string",
+                    "example": "FullTime"
+                },
+                "round_title": {
+                    "description": "The title of the round",
+                    "type": "string",
+                    "example": "Game 1"
+                },
+                "winner_team_id": {
+                    "description": "Team that won the game, if it is complete and was not drawn",
+                    "type": "integer",
+                    "example": 500004
+                }
+            }
+        },
+        "models.APISeriesTeam": {
+            "type": "object",
+            "properties": {
+                "nickname": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Blues"
+                },
+                "team_id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500003
+                },
+                "wins": {
+                    "description": "Number of games won in the series",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.APITeam": {
+            "type": "object",
+            "properties": {
+                "form": {
+                    "description": "Recent form of the team",
+                    "type": "string",
+                    "example": "WLWWL"
+                },
+                "form_detail": {
+                    "description": "Recent results of the team in the competition before the match",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APITeamForm"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500012
+                },
+                "late_changes": {
+                    "description": "Changes to the team list since it was announced, only included with match details",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APITeamListChange"
+                    }
+                },
+                "nickname": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Cowboys"
+                },
+                "odds": {
+                    "description": "Odds for the team to win",
+                    "type": "number",
+                    "example": 3.42
+                },
+                "score": {
+                    "description": "Final score of the team",
+                    "type": "integer",
+                    "example": 40
+                },
+                "squad": {
+                    "description": "Players named in the team list, only included with match details",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIPlayer"
+                    }
+                }
+            }
+        },
+        "models.APITeamCompetition": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "description": "The competition ID",
+                    "type": "integer",
+                    "example": 111
+                },
+                "seasons": {
+                    "description": "Seasons the team played in the competition",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        2023,
+                        2024
+                    ]
+                }
+            }
+        },
+        "models.APITeamDetails": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Short code for the team",
+                    "type": "string",
+                    "example": "NQL"
+                },
+                "competitions": {
+                    "description": "Competitions the team has played in",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APITeamCompetition"
+                    }
+                },
+                "home_grounds": {
+                    "description": "Venues of the team's home matches, only included for a single team",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIHomeGround"
+                    }
+                },
+                "id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500012
+                },
+                "logo_url": {
+                    "description": "URL of the team badge",
+                    "type": "string",
+                    "example": "https://www.nrl.com/.theme/cowboys/badge.svg"
+                },
+                "name": {
+                    "description": "Full name of the team",
+                    "type": "string",
+                    "example": "North Queensland Toyota Cowboys"
+                },
+                "nickname": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Cowboys"
+                },
+                "primary_colour": {
+                    "description": "Primary theme colour as a hex code",
+                    "type": "string",
+                    "example": "#002B5C"
+                },
+                "secondary_colour": {
+                    "description": "Secondary theme colour as a hex code",
+                    "type": "string",
+                    "example": "#FFDD02"
+                }
+            }
+        },
+        "models.APITeamForm": {
+            "type": "object",
+            "properties": {
+                "away": {
+                    "description": "Recent results played away",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIFormSplit"
+                        }
+                    ]
+                },
+                "draws": {
+                    "description": "Number of draws in the recent results",
+                    "type": "integer",
+                    "example": 1
+                },
+                "home": {
+                    "description": "Recent results played at home",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIFormSplit"
+                        }
+                    ]
+                },
+                "losses": {
+                    "description": "Number of losses in the recent results",
+                    "type": "integer",
+                    "example": 1
+                },
+                "points_against": {
+                    "description": "Points conceded in the recent results",
+                    "type": "integer",
+                    "example": 84
+                },
+                "points_for": {
+                    "description": "Points scored in the recent results",
+                    "type": "integer",
+                    "example": 112
+                },
+                "results": {
+                    "description": "Most recent results first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIFormResult"
+                    }
+                },
+                "wins": {
+                    "description": "Number of wins in the recent results",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.APITeamListChange": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "description": "Type of change (In, Out or Position)",
+                    "type": "string",
+                    "example": "Out"
+                },
+                "detected_at": {
+                    "description": "Time the change was detected",
+                    "type": "string",
+                    "example": "2024-08-29T05:00:00Z"
+                },
+                "player": {
+                    "description": "Player affected, with their number and position after the change (or before it if out)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.APIPlayer"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.APITeamRating": {
+            "type": "object",
+            "properties": {
+                "matches": {
+                    "description": "Number of completed matches the rating was calculated from",
+                    "type": "integer",
+                    "example": 120
+                },
+                "nickname": {
+                    "description": "Nickname of the team",
+                    "type": "string",
+                    "example": "Storm"
+                },
+                "rating": {
+                    "description": "Elo rating of the team",
+                    "type": "number",
+                    "example": 1612.4
+                },
+                "season": {
+                    "description": "Latest season the rating was carried into",
+                    "type": "integer",
+                    "example": 2024
+                },
+                "team_id": {
+                    "description": "Unique identifier for the team",
+                    "type": "integer",
+                    "example": 500021
+                }
+            }
+        },
+        "models.APITeamStat": {
+            "type": "object",
+            "properties": {
+                "away_value": {
+                    "description": "Value for the away team",
+                    "type": "number",
+                    "example": 76
+                },
+                "category": {
+                    "description": "Group the statistic belongs to",
+                    "type": "string",
+                    "example": "Possession"
+                },
+                "home_value": {
+                    "description": "Value for the home team",
+                    "type": "number",
+                    "example": 81
+                },
+                "name": {
+                    "description": "Name of the statistic",
+                    "type": "string",
+                    "example": "Completion Rate"
                 }
             }
         }
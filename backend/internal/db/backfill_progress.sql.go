@@ -0,0 +1,113 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: backfill_progress.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getBackfillProgress = `-- name: GetBackfillProgress :one
+
+SELECT competition_id, season, round_number, fixtures, completed_at FROM backfill_progress
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND round_number = $3
+`
+
+type GetBackfillProgressParams struct {
+	CompetitionID int64
+	Season        int32
+	RoundNumber   int32
+}
+
+// The backfill_progress table records which rounds of historical seasons have
+// been fully imported, so the backfill command can be stopped and resumed.
+// Retrieve the import progress for a specific competition, season and round.
+func (q *Queries) GetBackfillProgress(ctx context.Context, arg GetBackfillProgressParams) (*BackfillProgress, error) {
+	row := q.db.QueryRow(ctx, getBackfillProgress, arg.CompetitionID, arg.Season, arg.RoundNumber)
+	var i BackfillProgress
+	err := row.Scan(
+		&i.CompetitionID,
+		&i.Season,
+		&i.RoundNumber,
+		&i.Fixtures,
+		&i.CompletedAt,
+	)
+	return &i, err
+}
+
+const listBackfillProgressByCompetitionID = `-- name: ListBackfillProgressByCompetitionID :many
+SELECT competition_id, season, round_number, fixtures, completed_at FROM backfill_progress
+WHERE competition_id = $1
+ORDER BY season, round_number
+`
+
+// Retrieve all imported rounds for a competition, ordered by season and round.
+func (q *Queries) ListBackfillProgressByCompetitionID(ctx context.Context, competitionID int64) ([]*BackfillProgress, error) {
+	rows, err := q.db.Query(ctx, listBackfillProgressByCompetitionID, competitionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*BackfillProgress
+	for rows.Next() {
+		var i BackfillProgress
+		if err := rows.Scan(
+			&i.CompetitionID,
+			&i.Season,
+			&i.RoundNumber,
+			&i.Fixtures,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markBackfillRoundComplete = `-- name: MarkBackfillRoundComplete :one
+INSERT INTO backfill_progress (
+  competition_id, season, round_number, fixtures, completed_at
+) VALUES (
+  $1, $2, $3, $4, NOW()
+)
+ON CONFLICT (competition_id, season, round_number) DO UPDATE
+SET 
+  fixtures = EXCLUDED.fixtures,
+  completed_at = EXCLUDED.completed_at
+RETURNING competition_id, season, round_number, fixtures, completed_at
+`
+
+type MarkBackfillRoundCompleteParams struct {
+	CompetitionID int64
+	Season        int32
+	RoundNumber   int32
+	Fixtures      int32
+}
+
+// Record a round as fully imported, replacing any previous record for it.
+func (q *Queries) MarkBackfillRoundComplete(ctx context.Context, arg MarkBackfillRoundCompleteParams) (*BackfillProgress, error) {
+	row := q.db.QueryRow(ctx, markBackfillRoundComplete,
+		arg.CompetitionID,
+		arg.Season,
+		arg.RoundNumber,
+		arg.Fixtures,
+	)
+	var i BackfillProgress
+	err := row.Scan(
+		&i.CompetitionID,
+		&i.Season,
+		&i.RoundNumber,
+		&i.Fixtures,
+		&i.CompletedAt,
+	)
+	return &i, err
+}
@@ -10,20 +10,40 @@ import (
 )
 
 const getCompetitionByID = `-- name: GetCompetitionByID :one
-SELECT id, name, round FROM competitions WHERE id = $1
+SELECT id, name FROM competitions WHERE id = $1
 `
 
 // Retrieve a specific competition by its unique identifier.
 func (q *Queries) GetCompetitionByID(ctx context.Context, id int64) (*Competition, error) {
 	row := q.db.QueryRow(ctx, getCompetitionByID, id)
 	var i Competition
-	err := row.Scan(&i.ID, &i.Name, &i.Round)
+	err := row.Scan(&i.ID, &i.Name)
+	return &i, err
+}
+
+const getCompetitionSeason = `-- name: GetCompetitionSeason :one
+SELECT competition_id, season, round FROM competition_seasons 
+WHERE 
+  competition_id = $1
+  AND season = $2
+`
+
+type GetCompetitionSeasonParams struct {
+	CompetitionID int64
+	Season        int32
+}
+
+// Retrieve the state of a specific season of a competition.
+func (q *Queries) GetCompetitionSeason(ctx context.Context, arg GetCompetitionSeasonParams) (*CompetitionSeason, error) {
+	row := q.db.QueryRow(ctx, getCompetitionSeason, arg.CompetitionID, arg.Season)
+	var i CompetitionSeason
+	err := row.Scan(&i.CompetitionID, &i.Season, &i.Round)
 	return &i, err
 }
 
 const listCompetitions = `-- name: ListCompetitions :many
 
-SELECT id, name, round FROM competitions
+SELECT id, name FROM competitions
 `
 
 // The competitions table is a static table that stores information about the
@@ -39,7 +59,7 @@ func (q *Queries) ListCompetitions(ctx context.Context) ([]*Competition, error)
 	var items []*Competition
 	for rows.Next() {
 		var i Competition
-		if err := rows.Scan(&i.ID, &i.Name, &i.Round); err != nil {
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -50,18 +70,20 @@ func (q *Queries) ListCompetitions(ctx context.Context) ([]*Competition, error)
 	return items, nil
 }
 
-const updateCompetitionRound = `-- name: UpdateCompetitionRound :one
+const upsertCompetitionSeasonRound = `-- name: UpsertCompetitionSeasonRound :one
 
 
-UPDATE competitions
-SET round = $2
-WHERE id = $1
-RETURNING id, name, round
+INSERT INTO competition_seasons (competition_id, season, round)
+VALUES ($1, $2, $3)
+ON CONFLICT (competition_id, season) DO UPDATE
+SET round = EXCLUDED.round
+RETURNING competition_id, season, round
 `
 
-type UpdateCompetitionRoundParams struct {
-	ID    int64
-	Round *string
+type UpsertCompetitionSeasonRoundParams struct {
+	CompetitionID int64
+	Season        int32
+	Round         *string
 }
 
 // The following commands for creating, updating, and deleting competitions
@@ -73,12 +95,12 @@ type UpdateCompetitionRoundParams struct {
 //
 // However, if future updates to this table are needed (e.g., new competitions),
 // you may add additional commands to handle such changes.
-// Update the current round for a competition.
-// This query updates the round field for a specific competition based on the
-// provided competition ID.
-func (q *Queries) UpdateCompetitionRound(ctx context.Context, arg UpdateCompetitionRoundParams) (*Competition, error) {
-	row := q.db.QueryRow(ctx, updateCompetitionRound, arg.ID, arg.Round)
-	var i Competition
-	err := row.Scan(&i.ID, &i.Name, &i.Round)
+// Set the current round for a season of a competition.
+// This query creates the season record if it does not exist, otherwise it
+// updates the round field for the season.
+func (q *Queries) UpsertCompetitionSeasonRound(ctx context.Context, arg UpsertCompetitionSeasonRoundParams) (*CompetitionSeason, error) {
+	row := q.db.QueryRow(ctx, upsertCompetitionSeasonRound, arg.CompetitionID, arg.Season, arg.Round)
+	var i CompetitionSeason
+	err := row.Scan(&i.CompetitionID, &i.Season, &i.Round)
 	return &i, err
 }
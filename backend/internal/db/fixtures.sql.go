@@ -13,36 +13,42 @@ import (
 
 const createFixture = `-- name: CreateFixture :one
 INSERT INTO fixtures (
-  id, competition_id, roundTitle, matchState, venue, venueCity, matchCentreUrl, kickOffTime
+  id, competition_id, season, round_number, roundTitle, matchState, venue, venueCity, venue_id, matchCentreUrl, kickOffTime
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
-RETURNING id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime
+RETURNING id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime, season, round_number, venue_id
 `
 
 type CreateFixtureParams struct {
 	ID             int64
 	CompetitionID  int64
+	Season         int32
+	RoundNumber    int32
 	Roundtitle     string
 	Matchstate     string
 	Venue          string
 	Venuecity      string
+	VenueID        *int32
 	Matchcentreurl string
-	Kickofftime    pgtype.Timestamp
+	Kickofftime    pgtype.Timestamptz
 }
 
 // Insert a new fixture into the fixtures table.
 // This query adds a new fixture record with the specified details, such as
-// competition ID, round title, match state, venue, venue city, match center URL,
-// and kickoff time.
+// competition ID, season, round number, round title, match state, venue, venue
+// city, venue ID, match center URL, and kickoff time.
 func (q *Queries) CreateFixture(ctx context.Context, arg CreateFixtureParams) (*Fixture, error) {
 	row := q.db.QueryRow(ctx, createFixture,
 		arg.ID,
 		arg.CompetitionID,
+		arg.Season,
+		arg.RoundNumber,
 		arg.Roundtitle,
 		arg.Matchstate,
 		arg.Venue,
 		arg.Venuecity,
+		arg.VenueID,
 		arg.Matchcentreurl,
 		arg.Kickofftime,
 	)
@@ -56,12 +62,15 @@ func (q *Queries) CreateFixture(ctx context.Context, arg CreateFixtureParams) (*
 		&i.Venuecity,
 		&i.Matchcentreurl,
 		&i.Kickofftime,
+		&i.Season,
+		&i.RoundNumber,
+		&i.VenueID,
 	)
 	return &i, err
 }
 
 const getFixtureByID = `-- name: GetFixtureByID :one
-SELECT id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime FROM fixtures WHERE id = $1
+SELECT id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime, season, round_number, venue_id FROM fixtures WHERE id = $1
 `
 
 // Retrieve a specific fixture by its unique identifier.
@@ -78,12 +87,15 @@ func (q *Queries) GetFixtureByID(ctx context.Context, id int64) (*Fixture, error
 		&i.Venuecity,
 		&i.Matchcentreurl,
 		&i.Kickofftime,
+		&i.Season,
+		&i.RoundNumber,
+		&i.VenueID,
 	)
 	return &i, err
 }
 
 const getFixturesByCompetitionID = `-- name: GetFixturesByCompetitionID :many
-SELECT id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime FROM fixtures 
+SELECT id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime, season, round_number, venue_id FROM fixtures 
 WHERE competition_id = $1
 ORDER BY kickOffTime
 `
@@ -109,6 +121,9 @@ func (q *Queries) GetFixturesByCompetitionID(ctx context.Context, competitionID
 			&i.Venuecity,
 			&i.Matchcentreurl,
 			&i.Kickofftime,
+			&i.Season,
+			&i.RoundNumber,
+			&i.VenueID,
 		); err != nil {
 			return nil, err
 		}
@@ -121,7 +136,7 @@ func (q *Queries) GetFixturesByCompetitionID(ctx context.Context, competitionID
 }
 
 const listFixtures = `-- name: ListFixtures :many
-SELECT id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime FROM fixtures
+SELECT id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime, season, round_number, venue_id FROM fixtures
 `
 
 // Retrieve all fixtures available in the system.
@@ -144,6 +159,9 @@ func (q *Queries) ListFixtures(ctx context.Context) ([]*Fixture, error) {
 			&i.Venuecity,
 			&i.Matchcentreurl,
 			&i.Kickofftime,
+			&i.Season,
+			&i.RoundNumber,
+			&i.VenueID,
 		); err != nil {
 			return nil, err
 		}
@@ -157,14 +175,22 @@ func (q *Queries) ListFixtures(ctx context.Context) ([]*Fixture, error) {
 
 const updateFixture = `-- name: UpdateFixture :one
 UPDATE fixtures 
-SET matchState = COALESCE($2, matchState)
+SET matchState = COALESCE($2, matchState),
+    kickOffTime = COALESCE($3, kickOffTime),
+    venue = COALESCE($4, venue),
+    venueCity = COALESCE($5, venueCity),
+    venue_id = COALESCE($6, venue_id)
 WHERE id = $1
-RETURNING id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime
+RETURNING id, competition_id, roundtitle, matchstate, venue, venuecity, matchcentreurl, kickofftime, season, round_number, venue_id
 `
 
 type UpdateFixtureParams struct {
-	ID         int64
-	MatchState *string
+	ID          int64
+	MatchState  *string
+	KickOffTime pgtype.Timestamptz
+	Venue       *string
+	VenueCity   *string
+	VenueID     *int32
 }
 
 // Conditionally update fixture details based on provided arguments.
@@ -172,7 +198,14 @@ type UpdateFixtureParams struct {
 // are not NULL. It uses the COALESCE function to retain the existing value if
 // the argument is NULL.
 func (q *Queries) UpdateFixture(ctx context.Context, arg UpdateFixtureParams) (*Fixture, error) {
-	row := q.db.QueryRow(ctx, updateFixture, arg.ID, arg.MatchState)
+	row := q.db.QueryRow(ctx, updateFixture,
+		arg.ID,
+		arg.MatchState,
+		arg.KickOffTime,
+		arg.Venue,
+		arg.VenueCity,
+		arg.VenueID,
+	)
 	var i Fixture
 	err := row.Scan(
 		&i.ID,
@@ -183,6 +216,9 @@ func (q *Queries) UpdateFixture(ctx context.Context, arg UpdateFixtureParams) (*
 		&i.Venuecity,
 		&i.Matchcentreurl,
 		&i.Kickofftime,
+		&i.Season,
+		&i.RoundNumber,
+		&i.VenueID,
 	)
 	return &i, err
 }
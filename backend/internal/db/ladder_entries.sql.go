@@ -0,0 +1,268 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: ladder_entries.sql
+
+package db
+
+import (
+	"context"
+)
+
+const listLadderByesByCompetitionID = `-- name: ListLadderByesByCompetitionID :many
+SELECT 
+  team_id,
+  COUNT(*)::INTEGER AS byes
+FROM round_byes
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND round_number <= $3
+GROUP BY team_id
+`
+
+type ListLadderByesByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        int32
+	Round         int32
+}
+
+type ListLadderByesByCompetitionIDRow struct {
+	TeamID int64
+	Byes   int32
+}
+
+// Retrieve the number of byes each team has had in a competition season up to
+// and including a round, used to recalculate the ladder.
+func (q *Queries) ListLadderByesByCompetitionID(ctx context.Context, arg ListLadderByesByCompetitionIDParams) ([]*ListLadderByesByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listLadderByesByCompetitionID, arg.CompetitionID, arg.Season, arg.Round)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListLadderByesByCompetitionIDRow
+	for rows.Next() {
+		var i ListLadderByesByCompetitionIDRow
+		if err := rows.Scan(&i.TeamID, &i.Byes); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLadderEntriesByCompetitionID = `-- name: ListLadderEntriesByCompetitionID :many
+SELECT 
+  le.competition_id, le.season, le.round_number, le.team_id, le.position, le.played, le.wins, le.draws, le.losses, le.byes, le.points_for, le.points_against, le.points_difference, le.points, le.updated_at, 
+  t.id, t.nickname, t.name, t.code, t.primary_colour, t.secondary_colour, t.logo_url
+FROM ladder_entries le
+JOIN teams t ON le.team_id = t.id
+WHERE 
+  le.competition_id = $1
+  AND le.season = COALESCE(
+    $2::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = le.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM ladder_entries WHERE competition_id = le.competition_id)
+  )
+  AND le.round_number = COALESCE($3::INTEGER, (SELECT MAX(round_number) FROM ladder_entries WHERE competition_id = le.competition_id AND season = le.season))
+ORDER BY le.position
+`
+
+type ListLadderEntriesByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+	Round         *int32
+}
+
+type ListLadderEntriesByCompetitionIDRow struct {
+	LadderEntry LadderEntry
+	Team        Team
+}
+
+// Retrieve the ladder of a competition as at a round, ordered by position.
+// If no season is given, the season of the competition's current round is used,
+// or its latest season if it has no current round, and if no round is given, the
+// latest round with a ladder in that season is used.
+func (q *Queries) ListLadderEntriesByCompetitionID(ctx context.Context, arg ListLadderEntriesByCompetitionIDParams) ([]*ListLadderEntriesByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listLadderEntriesByCompetitionID, arg.CompetitionID, arg.Season, arg.Round)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListLadderEntriesByCompetitionIDRow
+	for rows.Next() {
+		var i ListLadderEntriesByCompetitionIDRow
+		if err := rows.Scan(
+			&i.LadderEntry.CompetitionID,
+			&i.LadderEntry.Season,
+			&i.LadderEntry.RoundNumber,
+			&i.LadderEntry.TeamID,
+			&i.LadderEntry.Position,
+			&i.LadderEntry.Played,
+			&i.LadderEntry.Wins,
+			&i.LadderEntry.Draws,
+			&i.LadderEntry.Losses,
+			&i.LadderEntry.Byes,
+			&i.LadderEntry.PointsFor,
+			&i.LadderEntry.PointsAgainst,
+			&i.LadderEntry.PointsDifference,
+			&i.LadderEntry.Points,
+			&i.LadderEntry.UpdatedAt,
+			&i.Team.ID,
+			&i.Team.Nickname,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLadderResultsByCompetitionID = `-- name: ListLadderResultsByCompetitionID :many
+SELECT 
+  md.homeTeam_id,
+  md.awayTeam_id,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+WHERE 
+  f.competition_id = $1
+  AND f.season = $2
+  AND f.round_number <= $3
+  AND f.matchState = 'FullTime'
+  AND r.type = 'Regular'
+  AND md.homeTeam_score IS NOT NULL
+  AND md.awayTeam_score IS NOT NULL
+`
+
+type ListLadderResultsByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        int32
+	Round         int32
+}
+
+type ListLadderResultsByCompetitionIDRow struct {
+	HometeamID    int64
+	AwayteamID    int64
+	HometeamScore *int32
+	AwayteamScore *int32
+}
+
+// Retrieve the scores of every completed regular season match of a competition
+// season up to and including a round, used to recalculate the ladder.
+func (q *Queries) ListLadderResultsByCompetitionID(ctx context.Context, arg ListLadderResultsByCompetitionIDParams) ([]*ListLadderResultsByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listLadderResultsByCompetitionID, arg.CompetitionID, arg.Season, arg.Round)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListLadderResultsByCompetitionIDRow
+	for rows.Next() {
+		var i ListLadderResultsByCompetitionIDRow
+		if err := rows.Scan(
+			&i.HometeamID,
+			&i.AwayteamID,
+			&i.HometeamScore,
+			&i.AwayteamScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertLadderEntry = `-- name: UpsertLadderEntry :one
+INSERT INTO ladder_entries (
+  competition_id, season, round_number, team_id, position, played, wins, draws, 
+  losses, byes, points_for, points_against, points_difference, points
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+ON CONFLICT (competition_id, season, round_number, team_id) DO UPDATE
+SET 
+  position = EXCLUDED.position,
+  played = EXCLUDED.played,
+  wins = EXCLUDED.wins,
+  draws = EXCLUDED.draws,
+  losses = EXCLUDED.losses,
+  byes = EXCLUDED.byes,
+  points_for = EXCLUDED.points_for,
+  points_against = EXCLUDED.points_against,
+  points_difference = EXCLUDED.points_difference,
+  points = EXCLUDED.points,
+  updated_at = NOW()
+RETURNING competition_id, season, round_number, team_id, position, played, wins, draws, losses, byes, points_for, points_against, points_difference, points, updated_at
+`
+
+type UpsertLadderEntryParams struct {
+	CompetitionID    int64
+	Season           int32
+	RoundNumber      int32
+	TeamID           int64
+	Position         int32
+	Played           int32
+	Wins             int32
+	Draws            int32
+	Losses           int32
+	Byes             int32
+	PointsFor        int32
+	PointsAgainst    int32
+	PointsDifference int32
+	Points           int32
+}
+
+// Insert a team's ladder entry for a round, or update it if it already exists.
+func (q *Queries) UpsertLadderEntry(ctx context.Context, arg UpsertLadderEntryParams) (*LadderEntry, error) {
+	row := q.db.QueryRow(ctx, upsertLadderEntry,
+		arg.CompetitionID,
+		arg.Season,
+		arg.RoundNumber,
+		arg.TeamID,
+		arg.Position,
+		arg.Played,
+		arg.Wins,
+		arg.Draws,
+		arg.Losses,
+		arg.Byes,
+		arg.PointsFor,
+		arg.PointsAgainst,
+		arg.PointsDifference,
+		arg.Points,
+	)
+	var i LadderEntry
+	err := row.Scan(
+		&i.CompetitionID,
+		&i.Season,
+		&i.RoundNumber,
+		&i.TeamID,
+		&i.Position,
+		&i.Played,
+		&i.Wins,
+		&i.Draws,
+		&i.Losses,
+		&i.Byes,
+		&i.PointsFor,
+		&i.PointsAgainst,
+		&i.PointsDifference,
+		&i.Points,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
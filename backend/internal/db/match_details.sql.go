@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createMatchDetail = `-- name: CreateMatchDetail :one
@@ -67,22 +69,28 @@ func (q *Queries) CreateMatchDetail(ctx context.Context, arg CreateMatchDetailPa
 const getMatchDetailsByFixtureID = `-- name: GetMatchDetailsByFixtureID :one
 SELECT 
   md.fixture_id, md.hometeam_id, md.awayteam_id, md.hometeam_odds, md.awayteam_odds, md.hometeam_score, md.awayteam_score, md.hometeam_form, md.awayteam_form, md.winner_teamid, 
-  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, 
-  home_team.id, home_team.nickname, home_team.competition_id, 
-  away_team.id, away_team.nickname, away_team.competition_id
+  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, f.season, f.round_number, f.venue_id, 
+  home_team.id, home_team.nickname, home_team.name, home_team.code, home_team.primary_colour, home_team.secondary_colour, home_team.logo_url, 
+  away_team.id, away_team.nickname, away_team.name, away_team.code, away_team.primary_colour, away_team.secondary_colour, away_team.logo_url,
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
 WHERE md.fixture_id = $1
 ORDER BY f.kickOffTime
 `
 
 type GetMatchDetailsByFixtureIDRow struct {
-	MatchDetail MatchDetail
-	Fixture     Fixture
-	Team        Team
-	Team_2      Team
+	MatchDetail   MatchDetail
+	Fixture       Fixture
+	Team          Team
+	Team_2        Team
+	PointsWeight  int32
+	VenueTimezone *string
 }
 
 // Retrieve match details for a specific fixture by its unique fixture ID.
@@ -108,12 +116,65 @@ func (q *Queries) GetMatchDetailsByFixtureID(ctx context.Context, fixtureID int6
 		&i.Fixture.Venuecity,
 		&i.Fixture.Matchcentreurl,
 		&i.Fixture.Kickofftime,
+		&i.Fixture.Season,
+		&i.Fixture.RoundNumber,
+		&i.Fixture.VenueID,
 		&i.Team.ID,
 		&i.Team.Nickname,
-		&i.Team.CompetitionID,
+		&i.Team.Name,
+		&i.Team.Code,
+		&i.Team.PrimaryColour,
+		&i.Team.SecondaryColour,
+		&i.Team.LogoUrl,
 		&i.Team_2.ID,
 		&i.Team_2.Nickname,
-		&i.Team_2.CompetitionID,
+		&i.Team_2.Name,
+		&i.Team_2.Code,
+		&i.Team_2.PrimaryColour,
+		&i.Team_2.SecondaryColour,
+		&i.Team_2.LogoUrl,
+		&i.PointsWeight,
+		&i.VenueTimezone,
+	)
+	return &i, err
+}
+
+const getNextHeadToHeadFixture = `-- name: GetNextHeadToHeadFixture :one
+SELECT f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, f.season, f.round_number, f.venue_id
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+WHERE 
+  (
+    (md.homeTeam_id = $1 AND md.awayTeam_id = $2)
+    OR (md.homeTeam_id = $2 AND md.awayTeam_id = $1)
+  )
+  AND f.kickOffTime > $3
+ORDER BY f.kickOffTime
+LIMIT 1
+`
+
+type GetNextHeadToHeadFixtureParams struct {
+	TeamID     int64
+	OpponentID int64
+	After      pgtype.Timestamptz
+}
+
+// Retrieve the next fixture between two teams that kicks off after a time.
+func (q *Queries) GetNextHeadToHeadFixture(ctx context.Context, arg GetNextHeadToHeadFixtureParams) (*Fixture, error) {
+	row := q.db.QueryRow(ctx, getNextHeadToHeadFixture, arg.TeamID, arg.OpponentID, arg.After)
+	var i Fixture
+	err := row.Scan(
+		&i.ID,
+		&i.CompetitionID,
+		&i.Roundtitle,
+		&i.Matchstate,
+		&i.Venue,
+		&i.Venuecity,
+		&i.Matchcentreurl,
+		&i.Kickofftime,
+		&i.Season,
+		&i.RoundNumber,
+		&i.VenueID,
 	)
 	return &i, err
 }
@@ -121,32 +182,50 @@ func (q *Queries) GetMatchDetailsByFixtureID(ctx context.Context, fixtureID int6
 const listCurrentRoundMatchDetailsByCompetitionID = `-- name: ListCurrentRoundMatchDetailsByCompetitionID :many
 SELECT 
   md.fixture_id, md.hometeam_id, md.awayteam_id, md.hometeam_odds, md.awayteam_odds, md.hometeam_score, md.awayteam_score, md.hometeam_form, md.awayteam_form, md.winner_teamid, 
-  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, 
-  home_team.id, home_team.nickname, home_team.competition_id, 
-  away_team.id, away_team.nickname, away_team.competition_id
+  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, f.season, f.round_number, f.venue_id, 
+  home_team.id, home_team.nickname, home_team.name, home_team.code, home_team.primary_colour, home_team.secondary_colour, home_team.logo_url, 
+  away_team.id, away_team.nickname, away_team.name, away_team.code, away_team.primary_colour, away_team.secondary_colour, away_team.logo_url,
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
-JOIN competitions c ON f.competition_id = c.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
+JOIN competition_seasons cs ON f.competition_id = cs.competition_id AND f.season = cs.season
 WHERE 
-  c.id = $1
-  AND f.roundTitle = c.round
+  cs.competition_id = $1
+  AND f.roundTitle = cs.round
+  AND f.season = COALESCE(
+    $2::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime
 `
 
+type ListCurrentRoundMatchDetailsByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+}
+
 type ListCurrentRoundMatchDetailsByCompetitionIDRow struct {
-	MatchDetail MatchDetail
-	Fixture     Fixture
-	Team        Team
-	Team_2      Team
+	MatchDetail   MatchDetail
+	Fixture       Fixture
+	Team          Team
+	Team_2        Team
+	PointsWeight  int32
+	VenueTimezone *string
 }
 
 // Retrieve all match details for a specific competition ID.
 // This query performs a JOIN between match_details and fixtures to get all
-// match details that are part of a specific competition and round.
-func (q *Queries) ListCurrentRoundMatchDetailsByCompetitionID(ctx context.Context, id int64) ([]*ListCurrentRoundMatchDetailsByCompetitionIDRow, error) {
-	rows, err := q.db.Query(ctx, listCurrentRoundMatchDetailsByCompetitionID, id)
+// match details that are part of the current round of a competition season.
+// If no season is given, the season of the competition's current round is used,
+// or its latest season if it has no current round.
+func (q *Queries) ListCurrentRoundMatchDetailsByCompetitionID(ctx context.Context, arg ListCurrentRoundMatchDetailsByCompetitionIDParams) ([]*ListCurrentRoundMatchDetailsByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listCurrentRoundMatchDetailsByCompetitionID, arg.CompetitionID, arg.Season)
 	if err != nil {
 		return nil, err
 	}
@@ -173,12 +252,104 @@ func (q *Queries) ListCurrentRoundMatchDetailsByCompetitionID(ctx context.Contex
 			&i.Fixture.Venuecity,
 			&i.Fixture.Matchcentreurl,
 			&i.Fixture.Kickofftime,
+			&i.Fixture.Season,
+			&i.Fixture.RoundNumber,
+			&i.Fixture.VenueID,
 			&i.Team.ID,
 			&i.Team.Nickname,
-			&i.Team.CompetitionID,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
 			&i.Team_2.ID,
 			&i.Team_2.Nickname,
-			&i.Team_2.CompetitionID,
+			&i.Team_2.Name,
+			&i.Team_2.Code,
+			&i.Team_2.PrimaryColour,
+			&i.Team_2.SecondaryColour,
+			&i.Team_2.LogoUrl,
+			&i.PointsWeight,
+			&i.VenueTimezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHeadToHeadResults = `-- name: ListHeadToHeadResults :many
+SELECT 
+  md.fixture_id,
+  f.competition_id,
+  f.season,
+  f.roundTitle,
+  f.venue,
+  f.venueCity,
+  f.venue_id,
+  f.kickOffTime,
+  md.homeTeam_id,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+WHERE 
+  (
+    (md.homeTeam_id = $1 AND md.awayTeam_id = $2)
+    OR (md.homeTeam_id = $2 AND md.awayTeam_id = $1)
+  )
+  AND f.matchState = 'FullTime'
+  AND md.homeTeam_score IS NOT NULL
+  AND md.awayTeam_score IS NOT NULL
+ORDER BY f.kickOffTime DESC
+`
+
+type ListHeadToHeadResultsParams struct {
+	TeamID     int64
+	OpponentID int64
+}
+
+type ListHeadToHeadResultsRow struct {
+	FixtureID     int64
+	CompetitionID int64
+	Season        int32
+	Roundtitle    string
+	Venue         string
+	Venuecity     string
+	VenueID       *int32
+	Kickofftime   pgtype.Timestamptz
+	HometeamID    int64
+	HometeamScore *int32
+	AwayteamScore *int32
+}
+
+// Retrieve every completed match between two teams, newest first, regardless of
+// which of them was the home team.
+func (q *Queries) ListHeadToHeadResults(ctx context.Context, arg ListHeadToHeadResultsParams) ([]*ListHeadToHeadResultsRow, error) {
+	rows, err := q.db.Query(ctx, listHeadToHeadResults, arg.TeamID, arg.OpponentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListHeadToHeadResultsRow
+	for rows.Next() {
+		var i ListHeadToHeadResultsRow
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.CompetitionID,
+			&i.Season,
+			&i.Roundtitle,
+			&i.Venue,
+			&i.Venuecity,
+			&i.VenueID,
+			&i.Kickofftime,
+			&i.HometeamID,
+			&i.HometeamScore,
+			&i.AwayteamScore,
 		); err != nil {
 			return nil, err
 		}
@@ -193,26 +364,39 @@ func (q *Queries) ListCurrentRoundMatchDetailsByCompetitionID(ctx context.Contex
 const listMatchDetails = `-- name: ListMatchDetails :many
 SELECT 
   md.fixture_id, md.hometeam_id, md.awayteam_id, md.hometeam_odds, md.awayteam_odds, md.hometeam_score, md.awayteam_score, md.hometeam_form, md.awayteam_form, md.winner_teamid, 
-  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, 
-  home_team.id, home_team.nickname, home_team.competition_id, 
-  away_team.id, away_team.nickname, away_team.competition_id
+  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, f.season, f.round_number, f.venue_id, 
+  home_team.id, home_team.nickname, home_team.name, home_team.code, home_team.primary_colour, home_team.secondary_colour, home_team.logo_url, 
+  away_team.id, away_team.nickname, away_team.name, away_team.code, away_team.primary_colour, away_team.secondary_colour, away_team.logo_url,
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
+WHERE f.season = COALESCE(
+    $1::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime
 `
 
 type ListMatchDetailsRow struct {
-	MatchDetail MatchDetail
-	Fixture     Fixture
-	Team        Team
-	Team_2      Team
+	MatchDetail   MatchDetail
+	Fixture       Fixture
+	Team          Team
+	Team_2        Team
+	PointsWeight  int32
+	VenueTimezone *string
 }
 
-// Retrieve all match details available in the system.
-func (q *Queries) ListMatchDetails(ctx context.Context) ([]*ListMatchDetailsRow, error) {
-	rows, err := q.db.Query(ctx, listMatchDetails)
+// Retrieve all match details available in the system for a season.
+// If no season is given, each competition defaults to the season of its current
+// round, or its latest season if it has no current round.
+func (q *Queries) ListMatchDetails(ctx context.Context, season *int32) ([]*ListMatchDetailsRow, error) {
+	rows, err := q.db.Query(ctx, listMatchDetails, season)
 	if err != nil {
 		return nil, err
 	}
@@ -239,12 +423,25 @@ func (q *Queries) ListMatchDetails(ctx context.Context) ([]*ListMatchDetailsRow,
 			&i.Fixture.Venuecity,
 			&i.Fixture.Matchcentreurl,
 			&i.Fixture.Kickofftime,
+			&i.Fixture.Season,
+			&i.Fixture.RoundNumber,
+			&i.Fixture.VenueID,
 			&i.Team.ID,
 			&i.Team.Nickname,
-			&i.Team.CompetitionID,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
 			&i.Team_2.ID,
 			&i.Team_2.Nickname,
-			&i.Team_2.CompetitionID,
+			&i.Team_2.Name,
+			&i.Team_2.Code,
+			&i.Team_2.PrimaryColour,
+			&i.Team_2.SecondaryColour,
+			&i.Team_2.LogoUrl,
+			&i.PointsWeight,
+			&i.VenueTimezone,
 		); err != nil {
 			return nil, err
 		}
@@ -259,29 +456,48 @@ func (q *Queries) ListMatchDetails(ctx context.Context) ([]*ListMatchDetailsRow,
 const listMatchDetailsByCompetitionID = `-- name: ListMatchDetailsByCompetitionID :many
 SELECT 
   md.fixture_id, md.hometeam_id, md.awayteam_id, md.hometeam_odds, md.awayteam_odds, md.hometeam_score, md.awayteam_score, md.hometeam_form, md.awayteam_form, md.winner_teamid, 
-  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, 
-  home_team.id, home_team.nickname, home_team.competition_id, 
-  away_team.id, away_team.nickname, away_team.competition_id
+  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, f.season, f.round_number, f.venue_id, 
+  home_team.id, home_team.nickname, home_team.name, home_team.code, home_team.primary_colour, home_team.secondary_colour, home_team.logo_url, 
+  away_team.id, away_team.nickname, away_team.name, away_team.code, away_team.primary_colour, away_team.secondary_colour, away_team.logo_url,
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
-WHERE f.competition_id = $1
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
+WHERE 
+  f.competition_id = $1
+  AND f.season = COALESCE(
+    $2::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime
 `
 
+type ListMatchDetailsByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+}
+
 type ListMatchDetailsByCompetitionIDRow struct {
-	MatchDetail MatchDetail
-	Fixture     Fixture
-	Team        Team
-	Team_2      Team
+	MatchDetail   MatchDetail
+	Fixture       Fixture
+	Team          Team
+	Team_2        Team
+	PointsWeight  int32
+	VenueTimezone *string
 }
 
 // Retrieve all match details for a specific competition ID.
 // This query performs a JOIN between match_details and fixtures to get all
-// match details that are part of a specific competition.
-func (q *Queries) ListMatchDetailsByCompetitionID(ctx context.Context, competitionID int64) ([]*ListMatchDetailsByCompetitionIDRow, error) {
-	rows, err := q.db.Query(ctx, listMatchDetailsByCompetitionID, competitionID)
+// match details that are part of a specific competition and season. If no
+// season is given, the season of the competition's current round is used, or its
+// latest season if it has no current round.
+func (q *Queries) ListMatchDetailsByCompetitionID(ctx context.Context, arg ListMatchDetailsByCompetitionIDParams) ([]*ListMatchDetailsByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listMatchDetailsByCompetitionID, arg.CompetitionID, arg.Season)
 	if err != nil {
 		return nil, err
 	}
@@ -308,12 +524,25 @@ func (q *Queries) ListMatchDetailsByCompetitionID(ctx context.Context, competiti
 			&i.Fixture.Venuecity,
 			&i.Fixture.Matchcentreurl,
 			&i.Fixture.Kickofftime,
+			&i.Fixture.Season,
+			&i.Fixture.RoundNumber,
+			&i.Fixture.VenueID,
 			&i.Team.ID,
 			&i.Team.Nickname,
-			&i.Team.CompetitionID,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
 			&i.Team_2.ID,
 			&i.Team_2.Nickname,
-			&i.Team_2.CompetitionID,
+			&i.Team_2.Name,
+			&i.Team_2.Code,
+			&i.Team_2.PrimaryColour,
+			&i.Team_2.SecondaryColour,
+			&i.Team_2.LogoUrl,
+			&i.PointsWeight,
+			&i.VenueTimezone,
 		); err != nil {
 			return nil, err
 		}
@@ -328,36 +557,50 @@ func (q *Queries) ListMatchDetailsByCompetitionID(ctx context.Context, competiti
 const listRoundMatchDetailsByCompetitionID = `-- name: ListRoundMatchDetailsByCompetitionID :many
 SELECT 
   md.fixture_id, md.hometeam_id, md.awayteam_id, md.hometeam_odds, md.awayteam_odds, md.hometeam_score, md.awayteam_score, md.hometeam_form, md.awayteam_form, md.winner_teamid, 
-  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, 
-  home_team.id, home_team.nickname, home_team.competition_id, 
-  away_team.id, away_team.nickname, away_team.competition_id
+  f.id, f.competition_id, f.roundtitle, f.matchstate, f.venue, f.venuecity, f.matchcentreurl, f.kickofftime, f.season, f.round_number, f.venue_id, 
+  home_team.id, home_team.nickname, home_team.name, home_team.code, home_team.primary_colour, home_team.secondary_colour, home_team.logo_url, 
+  away_team.id, away_team.nickname, away_team.name, away_team.code, away_team.primary_colour, away_team.secondary_colour, away_team.logo_url,
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
 WHERE 
   f.competition_id = $1
-  AND f.roundTitle = $2
+  AND f.round_number = $2
+  AND f.season = COALESCE(
+    $3::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime
 `
 
 type ListRoundMatchDetailsByCompetitionIDParams struct {
 	CompetitionID int64
-	Roundtitle    string
+	RoundNumber   int32
+	Season        *int32
 }
 
 type ListRoundMatchDetailsByCompetitionIDRow struct {
-	MatchDetail MatchDetail
-	Fixture     Fixture
-	Team        Team
-	Team_2      Team
+	MatchDetail   MatchDetail
+	Fixture       Fixture
+	Team          Team
+	Team_2        Team
+	PointsWeight  int32
+	VenueTimezone *string
 }
 
 // Retrieve all match details for a specific competition ID.
 // This query performs a JOIN between match_details and fixtures to get all
-// match details that are part of a specific competition and round.
+// match details that are part of a specific competition, season and round. If
+// no season is given, the season of the competition's current round is used, or
+// its latest season if it has no current round.
 func (q *Queries) ListRoundMatchDetailsByCompetitionID(ctx context.Context, arg ListRoundMatchDetailsByCompetitionIDParams) ([]*ListRoundMatchDetailsByCompetitionIDRow, error) {
-	rows, err := q.db.Query(ctx, listRoundMatchDetailsByCompetitionID, arg.CompetitionID, arg.Roundtitle)
+	rows, err := q.db.Query(ctx, listRoundMatchDetailsByCompetitionID, arg.CompetitionID, arg.RoundNumber, arg.Season)
 	if err != nil {
 		return nil, err
 	}
@@ -384,12 +627,209 @@ func (q *Queries) ListRoundMatchDetailsByCompetitionID(ctx context.Context, arg
 			&i.Fixture.Venuecity,
 			&i.Fixture.Matchcentreurl,
 			&i.Fixture.Kickofftime,
+			&i.Fixture.Season,
+			&i.Fixture.RoundNumber,
+			&i.Fixture.VenueID,
 			&i.Team.ID,
 			&i.Team.Nickname,
-			&i.Team.CompetitionID,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
 			&i.Team_2.ID,
 			&i.Team_2.Nickname,
-			&i.Team_2.CompetitionID,
+			&i.Team_2.Name,
+			&i.Team_2.Code,
+			&i.Team_2.PrimaryColour,
+			&i.Team_2.SecondaryColour,
+			&i.Team_2.LogoUrl,
+			&i.PointsWeight,
+			&i.VenueTimezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesFixturesByCompetitionID = `-- name: ListSeriesFixturesByCompetitionID :many
+SELECT 
+  f.id AS fixture_id,
+  f.season,
+  f.roundTitle,
+  f.matchState,
+  f.kickOffTime,
+  md.homeTeam_id,
+  md.awayTeam_id,
+  home_team.nickname AS homeTeam_nickname,
+  away_team.nickname AS awayTeam_nickname,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM fixtures f
+JOIN match_details md ON md.fixture_id = f.id
+JOIN teams home_team ON md.homeTeam_id = home_team.id
+JOIN teams away_team ON md.awayTeam_id = away_team.id
+WHERE 
+  f.competition_id = $1
+  AND f.season <= COALESCE($2::INTEGER, f.season)
+ORDER BY f.season, f.kickOffTime
+`
+
+type ListSeriesFixturesByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+}
+
+type ListSeriesFixturesByCompetitionIDRow struct {
+	FixtureID        int64
+	Season           int32
+	Roundtitle       string
+	Matchstate       string
+	Kickofftime      pgtype.Timestamptz
+	HometeamID       int64
+	AwayteamID       int64
+	HometeamNickname string
+	AwayteamNickname string
+	HometeamScore    *int32
+	AwayteamScore    *int32
+}
+
+// Retrieve every game of a series competition (e.g. State of Origin) up to and
+// including a season, oldest first, with the scores of the games played. If no
+// season is given, every season is included.
+func (q *Queries) ListSeriesFixturesByCompetitionID(ctx context.Context, arg ListSeriesFixturesByCompetitionIDParams) ([]*ListSeriesFixturesByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listSeriesFixturesByCompetitionID, arg.CompetitionID, arg.Season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListSeriesFixturesByCompetitionIDRow
+	for rows.Next() {
+		var i ListSeriesFixturesByCompetitionIDRow
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.Season,
+			&i.Roundtitle,
+			&i.Matchstate,
+			&i.Kickofftime,
+			&i.HometeamID,
+			&i.AwayteamID,
+			&i.HometeamNickname,
+			&i.AwayteamNickname,
+			&i.HometeamScore,
+			&i.AwayteamScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamFormResults = `-- name: ListTeamFormResults :many
+SELECT 
+  t.competition_id::BIGINT AS competition_id,
+  t.team_id::BIGINT AS team_id,
+  t.kick_off_before::TIMESTAMPTZ AS kick_off_before,
+  r.fixture_id,
+  r.kickOffTime,
+  r.homeTeam_id,
+  r.awayTeam_id,
+  r.homeTeam_nickname,
+  r.awayTeam_nickname,
+  r.homeTeam_score,
+  r.awayTeam_score
+FROM (
+  SELECT 
+    UNNEST($1::BIGINT[]) AS competition_id,
+    UNNEST($2::BIGINT[]) AS team_id,
+    UNNEST($3::TIMESTAMPTZ[]) AS kick_off_before
+) t
+CROSS JOIN LATERAL (
+  SELECT 
+    md.fixture_id,
+    f.kickOffTime,
+    md.homeTeam_id,
+    md.awayTeam_id,
+    home_team.nickname AS homeTeam_nickname,
+    away_team.nickname AS awayTeam_nickname,
+    md.homeTeam_score,
+    md.awayTeam_score
+  FROM match_details md
+  JOIN fixtures f ON md.fixture_id = f.id
+  JOIN teams home_team ON md.homeTeam_id = home_team.id
+  JOIN teams away_team ON md.awayTeam_id = away_team.id
+  WHERE 
+    f.competition_id = t.competition_id
+    AND (md.homeTeam_id = t.team_id OR md.awayTeam_id = t.team_id)
+    AND f.kickOffTime < t.kick_off_before
+    AND f.matchState = 'FullTime'
+    AND md.homeTeam_score IS NOT NULL
+    AND md.awayTeam_score IS NOT NULL
+  ORDER BY f.kickOffTime DESC
+  LIMIT $4::INTEGER
+) r
+ORDER BY t.competition_id, t.team_id, t.kick_off_before, r.kickOffTime DESC
+`
+
+type ListTeamFormResultsParams struct {
+	CompetitionIds []int64
+	TeamIds        []int64
+	KickOffsBefore []pgtype.Timestamptz
+	Matches        int32
+}
+
+type ListTeamFormResultsRow struct {
+	CompetitionID    int64
+	TeamID           int64
+	KickOffBefore    pgtype.Timestamptz
+	FixtureID        int64
+	Kickofftime      pgtype.Timestamptz
+	HometeamID       int64
+	AwayteamID       int64
+	HometeamNickname string
+	AwayteamNickname string
+	HometeamScore    *int32
+	AwayteamScore    *int32
+}
+
+// Retrieve the last completed matches of each of a set of teams in a competition
+// before a time, newest first, used to calculate the recent form of teams. Each
+// team is given with the kickoff time of the fixture its form is needed for.
+func (q *Queries) ListTeamFormResults(ctx context.Context, arg ListTeamFormResultsParams) ([]*ListTeamFormResultsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamFormResults,
+		arg.CompetitionIds,
+		arg.TeamIds,
+		arg.KickOffsBefore,
+		arg.Matches,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTeamFormResultsRow
+	for rows.Next() {
+		var i ListTeamFormResultsRow
+		if err := rows.Scan(
+			&i.CompetitionID,
+			&i.TeamID,
+			&i.KickOffBefore,
+			&i.FixtureID,
+			&i.Kickofftime,
+			&i.HometeamID,
+			&i.AwayteamID,
+			&i.HometeamNickname,
+			&i.AwayteamNickname,
+			&i.HometeamScore,
+			&i.AwayteamScore,
 		); err != nil {
 			return nil, err
 		}
@@ -408,7 +848,11 @@ SET
     awayTeam_odds = COALESCE($3, awayTeam_odds), 
     homeTeam_score = COALESCE($4, homeTeam_score), 
     awayTeam_score = COALESCE($5, awayTeam_score), 
-    winner_teamId = COALESCE($6, winner_teamId)
+    winner_teamId = CASE
+        WHEN $4::INTEGER IS NOT NULL AND $5::INTEGER IS NOT NULL
+        THEN $6
+        ELSE winner_teamId
+    END
 WHERE fixture_id = $1
 RETURNING fixture_id, hometeam_id, awayteam_id, hometeam_odds, awayteam_odds, hometeam_score, awayteam_score, hometeam_form, awayteam_form, winner_teamid
 `
@@ -423,7 +867,8 @@ type UpdateMatchDetailParams struct {
 }
 
 // Conditionally update match detail fields based on provided arguments.
-// Only updates fields where the argument is not NULL.
+// Only updates fields where the argument is not NULL, except the winner, which
+// is set whenever both scores are given so that a draw clears a previous winner.
 func (q *Queries) UpdateMatchDetail(ctx context.Context, arg UpdateMatchDetailParams) (*MatchDetail, error) {
 	row := q.db.QueryRow(ctx, updateMatchDetail,
 		arg.FixtureID,
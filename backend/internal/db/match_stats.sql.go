@@ -0,0 +1,169 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: match_stats.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createMatchEvent = `-- name: CreateMatchEvent :exec
+INSERT INTO match_events (
+  fixture_id, sequence, type, title, game_seconds, team_id, player_id, 
+  homeTeam_score, awayTeam_score
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+`
+
+type CreateMatchEventParams struct {
+	FixtureID     int64
+	Sequence      int32
+	Type          string
+	Title         string
+	GameSeconds   int32
+	TeamID        *int64
+	PlayerID      *int64
+	HometeamScore *int32
+	AwayteamScore *int32
+}
+
+// Insert an event into the timeline of a fixture.
+func (q *Queries) CreateMatchEvent(ctx context.Context, arg CreateMatchEventParams) error {
+	_, err := q.db.Exec(ctx, createMatchEvent,
+		arg.FixtureID,
+		arg.Sequence,
+		arg.Type,
+		arg.Title,
+		arg.GameSeconds,
+		arg.TeamID,
+		arg.PlayerID,
+		arg.HometeamScore,
+		arg.AwayteamScore,
+	)
+	return err
+}
+
+const deleteMatchEvents = `-- name: DeleteMatchEvents :exec
+DELETE FROM match_events
+WHERE fixture_id = $1
+`
+
+// Remove the timeline of a fixture, before storing the latest timeline.
+func (q *Queries) DeleteMatchEvents(ctx context.Context, fixtureID int64) error {
+	_, err := q.db.Exec(ctx, deleteMatchEvents, fixtureID)
+	return err
+}
+
+const listMatchEventsByFixtureID = `-- name: ListMatchEventsByFixtureID :many
+SELECT 
+  me.fixture_id, me.sequence, me.type, me.title, me.game_seconds, me.team_id, me.player_id, me.hometeam_score, me.awayteam_score, 
+  p.first_name, 
+  p.last_name
+FROM match_events me
+LEFT JOIN players p ON me.player_id = p.id
+WHERE me.fixture_id = $1
+ORDER BY me.sequence
+`
+
+type ListMatchEventsByFixtureIDRow struct {
+	MatchEvent MatchEvent
+	FirstName  *string
+	LastName   *string
+}
+
+// Retrieve the timeline of a fixture in order, with the name of the player
+// involved in each event if they are known.
+func (q *Queries) ListMatchEventsByFixtureID(ctx context.Context, fixtureID int64) ([]*ListMatchEventsByFixtureIDRow, error) {
+	rows, err := q.db.Query(ctx, listMatchEventsByFixtureID, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListMatchEventsByFixtureIDRow
+	for rows.Next() {
+		var i ListMatchEventsByFixtureIDRow
+		if err := rows.Scan(
+			&i.MatchEvent.FixtureID,
+			&i.MatchEvent.Sequence,
+			&i.MatchEvent.Type,
+			&i.MatchEvent.Title,
+			&i.MatchEvent.GameSeconds,
+			&i.MatchEvent.TeamID,
+			&i.MatchEvent.PlayerID,
+			&i.MatchEvent.HometeamScore,
+			&i.MatchEvent.AwayteamScore,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchTeamStatsByFixtureID = `-- name: ListMatchTeamStatsByFixtureID :many
+SELECT fixture_id, team_id, category, name, value FROM match_team_stats
+WHERE fixture_id = $1
+ORDER BY category, name, team_id
+`
+
+// Retrieve the statistics of both teams of a fixture.
+func (q *Queries) ListMatchTeamStatsByFixtureID(ctx context.Context, fixtureID int64) ([]*MatchTeamStat, error) {
+	rows, err := q.db.Query(ctx, listMatchTeamStatsByFixtureID, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MatchTeamStat
+	for rows.Next() {
+		var i MatchTeamStat
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.TeamID,
+			&i.Category,
+			&i.Name,
+			&i.Value,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertMatchTeamStat = `-- name: UpsertMatchTeamStat :exec
+INSERT INTO match_team_stats (fixture_id, team_id, category, name, value)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (fixture_id, team_id, category, name) DO UPDATE
+SET value = EXCLUDED.value
+`
+
+type UpsertMatchTeamStatParams struct {
+	FixtureID int64
+	TeamID    int64
+	Category  string
+	Name      string
+	Value     *float64
+}
+
+// Insert a team's statistic for a fixture, or update its value if it already exists.
+func (q *Queries) UpsertMatchTeamStat(ctx context.Context, arg UpsertMatchTeamStatParams) error {
+	_, err := q.db.Exec(ctx, upsertMatchTeamStat,
+		arg.FixtureID,
+		arg.TeamID,
+		arg.Category,
+		arg.Name,
+		arg.Value,
+	)
+	return err
+}
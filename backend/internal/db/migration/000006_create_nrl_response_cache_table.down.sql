@@ -0,0 +1 @@
+DROP TABLE IF EXISTS nrl_response_cache;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE nrl_response_cache (
+  url VARCHAR(512) PRIMARY KEY,
+  etag VARCHAR(255),
+  last_modified VARCHAR(255),
+  content_hash CHAR(64) NOT NULL,
+  body BYTEA NOT NULL,
+  fetched_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN nrl_response_cache.url IS 'Full URL of the NRL API document';
+COMMENT ON COLUMN nrl_response_cache.etag IS 'ETag header returned with the document, if any';
+COMMENT ON COLUMN nrl_response_cache.last_modified IS 'Last-Modified header returned with the document, if any';
+COMMENT ON COLUMN nrl_response_cache.content_hash IS 'SHA-256 hash of the document body';
+COMMENT ON COLUMN nrl_response_cache.body IS 'Raw body of the last fetched document';
+COMMENT ON COLUMN nrl_response_cache.fetched_at IS 'Time the document was last fetched';
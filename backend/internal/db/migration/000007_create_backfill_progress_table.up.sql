@@ -0,0 +1,14 @@
+CREATE TABLE backfill_progress (
+  competition_id BIGINT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+  season INTEGER NOT NULL,
+  round_number INTEGER NOT NULL,
+  fixtures INTEGER NOT NULL,
+  completed_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (competition_id, season, round_number)
+);
+
+COMMENT ON COLUMN backfill_progress.competition_id IS 'Foreign key referencing competitions table';
+COMMENT ON COLUMN backfill_progress.season IS 'Season the round belongs to (e.g., 2024)';
+COMMENT ON COLUMN backfill_progress.round_number IS 'Round number requested from the NRL draw';
+COMMENT ON COLUMN backfill_progress.fixtures IS 'Number of fixtures imported for the round';
+COMMENT ON COLUMN backfill_progress.completed_at IS 'Time the round was fully imported';
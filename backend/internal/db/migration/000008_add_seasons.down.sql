@@ -0,0 +1,19 @@
+ALTER TABLE competitions
+ADD COLUMN round VARCHAR(50);
+
+COMMENT ON COLUMN competitions.round IS 'Indicates the current round or game for the competition (e.g., Round 1 for NRL, Game 1 for State of Origin)';
+
+-- Restore the current round from the latest season of each competition
+UPDATE competitions c
+SET round = cs.round
+FROM competition_seasons cs
+WHERE 
+  cs.competition_id = c.id
+  AND cs.season = (SELECT MAX(season) FROM competition_seasons WHERE competition_id = c.id);
+
+DROP TABLE IF EXISTS competition_seasons;
+
+DROP INDEX IF EXISTS fixtures_competition_season_idx;
+
+ALTER TABLE fixtures
+DROP COLUMN season;
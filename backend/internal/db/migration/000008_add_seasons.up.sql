@@ -0,0 +1,37 @@
+-- Fixtures previously only carried their season inside the encoded match ID
+-- (e.g. 2024 in 20241112610), so populate the new column from it.
+ALTER TABLE fixtures
+ADD COLUMN season INTEGER;
+
+UPDATE fixtures
+SET season = CAST(LEFT(id::TEXT, 4) AS INTEGER);
+
+ALTER TABLE fixtures
+ALTER COLUMN season SET NOT NULL;
+
+CREATE INDEX fixtures_competition_season_idx ON fixtures (competition_id, season);
+
+COMMENT ON COLUMN fixtures.season IS 'Season the fixture belongs to (e.g., 2024)';
+
+-- The current round is tracked per season rather than once per competition, so
+-- that storing a past season does not affect the current one.
+CREATE TABLE competition_seasons (
+  competition_id BIGINT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+  season INTEGER NOT NULL,
+  round VARCHAR(50),
+  PRIMARY KEY (competition_id, season)
+);
+
+COMMENT ON COLUMN competition_seasons.competition_id IS 'Foreign key referencing competitions table';
+COMMENT ON COLUMN competition_seasons.season IS 'Season of the competition (e.g., 2024)';
+COMMENT ON COLUMN competition_seasons.round IS 'Indicates the current round or game for the season (e.g., Round 1 for NRL, Game 1 for State of Origin)';
+
+INSERT INTO competition_seasons (competition_id, season, round)
+SELECT c.id, MAX(f.season), c.round
+FROM competitions c
+JOIN fixtures f ON f.competition_id = c.id
+WHERE c.round IS NOT NULL
+GROUP BY c.id, c.round;
+
+ALTER TABLE competitions
+DROP COLUMN round;
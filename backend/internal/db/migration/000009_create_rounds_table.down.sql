@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS round_byes;
+
+DROP TABLE IF EXISTS rounds;
+
+ALTER TABLE fixtures
+DROP COLUMN round_number;
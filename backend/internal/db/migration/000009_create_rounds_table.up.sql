@@ -0,0 +1,67 @@
+-- Fixtures previously only carried their round number inside the round title
+-- (e.g. 26 in Round 26) and the encoded match ID (e.g. 26 in 20241112610), so
+-- populate the new column from the title as new fixtures are, falling back to
+-- the match ID for titles without a number such as finals weeks.
+ALTER TABLE fixtures
+ADD COLUMN round_number INTEGER;
+
+UPDATE fixtures
+SET round_number = COALESCE(
+  SUBSTRING(roundTitle FROM '^(?:Round|Game) (\d+)$')::INTEGER,
+  NULLIF(SUBSTRING(id::TEXT FROM 8 FOR 2), '')::INTEGER,
+  0
+);
+
+ALTER TABLE fixtures
+ALTER COLUMN round_number SET NOT NULL;
+
+COMMENT ON COLUMN fixtures.round_number IS 'Number of the round the fixture belongs to (e.g., 26)';
+
+CREATE TABLE rounds (
+  competition_id BIGINT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+  season INTEGER NOT NULL,
+  number INTEGER NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  type VARCHAR(50) NOT NULL,
+  first_kickoff TIMESTAMP WITHOUT TIME ZONE,
+  last_kickoff TIMESTAMP WITHOUT TIME ZONE,
+  PRIMARY KEY (competition_id, season, number)
+);
+
+COMMENT ON COLUMN rounds.competition_id IS 'Foreign key referencing competitions table';
+COMMENT ON COLUMN rounds.season IS 'Season the round belongs to (e.g., 2024)';
+COMMENT ON COLUMN rounds.number IS 'Number of the round within the season (e.g., 26)';
+COMMENT ON COLUMN rounds.title IS 'Title of the round (e.g., Round 26, Game 1, Finals Week 1)';
+COMMENT ON COLUMN rounds.type IS 'Type of the round (e.g., Regular, Finals, Origin)';
+COMMENT ON COLUMN rounds.first_kickoff IS 'Kickoff time of the first fixture in the round';
+COMMENT ON COLUMN rounds.last_kickoff IS 'Kickoff time of the last fixture in the round';
+
+INSERT INTO rounds (competition_id, season, number, title, type, first_kickoff, last_kickoff)
+SELECT
+  competition_id,
+  season,
+  round_number,
+  MIN(roundTitle),
+  CASE
+    WHEN competition_id IN (116, 156) THEN 'Origin'
+    WHEN MIN(roundTitle) LIKE 'Round %' THEN 'Regular'
+    ELSE 'Finals'
+  END,
+  MIN(kickOffTime),
+  MAX(kickOffTime)
+FROM fixtures
+GROUP BY competition_id, season, round_number;
+
+CREATE TABLE round_byes (
+  competition_id BIGINT NOT NULL,
+  season INTEGER NOT NULL,
+  round_number INTEGER NOT NULL,
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  PRIMARY KEY (competition_id, season, round_number, team_id),
+  FOREIGN KEY (competition_id, season, round_number) REFERENCES rounds(competition_id, season, number) ON DELETE CASCADE
+);
+
+COMMENT ON COLUMN round_byes.competition_id IS 'Competition of the round, part of the foreign key referencing rounds table';
+COMMENT ON COLUMN round_byes.season IS 'Season of the round, part of the foreign key referencing rounds table';
+COMMENT ON COLUMN round_byes.round_number IS 'Number of the round, part of the foreign key referencing rounds table';
+COMMENT ON COLUMN round_byes.team_id IS 'Foreign key referencing the team on the bye';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS rounds_competition_season_slug_idx;
+
+ALTER TABLE rounds
+DROP COLUMN points_weight,
+DROP COLUMN finals_week,
+DROP COLUMN slug;
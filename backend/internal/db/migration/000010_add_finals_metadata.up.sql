@@ -0,0 +1,34 @@
+ALTER TABLE rounds
+ADD COLUMN slug VARCHAR(255),
+ADD COLUMN finals_week INTEGER,
+ADD COLUMN points_weight INTEGER NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN rounds.slug IS 'URL friendly title used to address the round (e.g., round-26, finals-week-1, grand-final)';
+COMMENT ON COLUMN rounds.finals_week IS 'Week of the finals series the round is played in, starting at 1 (NULL for rounds outside the finals)';
+COMMENT ON COLUMN rounds.points_weight IS 'Multiplier applied to the points of tips on fixtures in the round';
+
+UPDATE rounds
+SET slug = LOWER(REPLACE(title, ' ', '-'));
+
+ALTER TABLE rounds
+ALTER COLUMN slug SET NOT NULL;
+
+CREATE INDEX rounds_competition_season_slug_idx ON rounds (competition_id, season, slug);
+
+-- Finals rounds directly follow the regular season, so the week of each
+-- finals round is its position after the first finals round of the season.
+UPDATE rounds r
+SET finals_week = r.number - f.first_number + 1
+FROM (
+  SELECT competition_id, season, MIN(number) AS first_number
+  FROM rounds
+  WHERE type = 'Finals'
+  GROUP BY competition_id, season
+) f
+WHERE 
+  r.competition_id = f.competition_id
+  AND r.season = f.season
+  AND r.type = 'Finals';
+
+-- The points weight of existing rounds is set from config when the server or
+-- backfill starts (see NRLDataService.RefreshRoundPointsWeights).
@@ -0,0 +1,34 @@
+CREATE TABLE ladder_entries (
+  competition_id BIGINT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+  season INTEGER NOT NULL,
+  round_number INTEGER NOT NULL,
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  played INTEGER NOT NULL,
+  wins INTEGER NOT NULL,
+  draws INTEGER NOT NULL,
+  losses INTEGER NOT NULL,
+  byes INTEGER NOT NULL,
+  points_for INTEGER NOT NULL,
+  points_against INTEGER NOT NULL,
+  points_difference INTEGER NOT NULL,
+  points INTEGER NOT NULL,
+  updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (competition_id, season, round_number, team_id)
+);
+
+COMMENT ON COLUMN ladder_entries.competition_id IS 'Foreign key referencing competitions table';
+COMMENT ON COLUMN ladder_entries.season IS 'Season of the ladder (e.g., 2024)';
+COMMENT ON COLUMN ladder_entries.round_number IS 'Round the ladder is as at, including the results of that round';
+COMMENT ON COLUMN ladder_entries.team_id IS 'Foreign key referencing teams table';
+COMMENT ON COLUMN ladder_entries.position IS 'Position of the team on the ladder, starting at 1';
+COMMENT ON COLUMN ladder_entries.played IS 'Number of matches played';
+COMMENT ON COLUMN ladder_entries.wins IS 'Number of matches won';
+COMMENT ON COLUMN ladder_entries.draws IS 'Number of matches drawn';
+COMMENT ON COLUMN ladder_entries.losses IS 'Number of matches lost';
+COMMENT ON COLUMN ladder_entries.byes IS 'Number of byes';
+COMMENT ON COLUMN ladder_entries.points_for IS 'Total points scored by the team';
+COMMENT ON COLUMN ladder_entries.points_against IS 'Total points scored against the team';
+COMMENT ON COLUMN ladder_entries.points_difference IS 'Points for minus points against';
+COMMENT ON COLUMN ladder_entries.points IS 'Competition points (e.g., 2 for a win or bye, 1 for a draw)';
+COMMENT ON COLUMN ladder_entries.updated_at IS 'Time the entry was last fetched from the NRL API';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS team_list_changes;
+
+DROP TABLE IF EXISTS team_lists;
+
+DROP TABLE IF EXISTS players;
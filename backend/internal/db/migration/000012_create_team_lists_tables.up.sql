@@ -0,0 +1,46 @@
+CREATE TABLE players (
+  id BIGINT PRIMARY KEY,
+  first_name VARCHAR(255) NOT NULL,
+  last_name VARCHAR(255) NOT NULL
+);
+
+COMMENT ON COLUMN players.id IS 'Unique identifier for the player, as used by the NRL API';
+COMMENT ON COLUMN players.first_name IS 'First name of the player';
+COMMENT ON COLUMN players.last_name IS 'Last name of the player';
+
+CREATE TABLE team_lists (
+  fixture_id BIGINT NOT NULL REFERENCES fixtures(id) ON DELETE CASCADE,
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  player_id BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  number INTEGER NOT NULL,
+  position VARCHAR(50) NOT NULL,
+  PRIMARY KEY (fixture_id, team_id, player_id)
+);
+
+COMMENT ON COLUMN team_lists.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN team_lists.team_id IS 'Foreign key referencing the team the player is named in';
+COMMENT ON COLUMN team_lists.player_id IS 'Foreign key referencing players table';
+COMMENT ON COLUMN team_lists.number IS 'Jersey number the player is named in (e.g., 1)';
+COMMENT ON COLUMN team_lists.position IS 'Position the player is named in (e.g., Fullback, Interchange, Reserve)';
+
+CREATE TABLE team_list_changes (
+  id SERIAL PRIMARY KEY,
+  fixture_id BIGINT NOT NULL REFERENCES fixtures(id) ON DELETE CASCADE,
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  player_id BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  change VARCHAR(50) NOT NULL,
+  number INTEGER NOT NULL,
+  position VARCHAR(50) NOT NULL,
+  detected_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX team_list_changes_fixture_idx ON team_list_changes (fixture_id);
+
+COMMENT ON COLUMN team_list_changes.id IS 'Unique identifier for the change';
+COMMENT ON COLUMN team_list_changes.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN team_list_changes.team_id IS 'Foreign key referencing the team whose list changed';
+COMMENT ON COLUMN team_list_changes.player_id IS 'Foreign key referencing the player affected by the change';
+COMMENT ON COLUMN team_list_changes.change IS 'Type of change (e.g., In, Out, Position)';
+COMMENT ON COLUMN team_list_changes.number IS 'Jersey number of the player after the change, or before it for players who are out';
+COMMENT ON COLUMN team_list_changes.position IS 'Position of the player after the change, or before it for players who are out';
+COMMENT ON COLUMN team_list_changes.detected_at IS 'Time the change was first seen in the NRL API';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS match_team_stats;
+
+DROP TABLE IF EXISTS match_events;
@@ -0,0 +1,37 @@
+CREATE TABLE match_events (
+  fixture_id BIGINT NOT NULL REFERENCES fixtures(id) ON DELETE CASCADE,
+  sequence INTEGER NOT NULL,
+  type VARCHAR(50) NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  game_seconds INTEGER NOT NULL,
+  team_id BIGINT REFERENCES teams(id) ON DELETE CASCADE,
+  player_id BIGINT,
+  homeTeam_score INTEGER,
+  awayTeam_score INTEGER,
+  PRIMARY KEY (fixture_id, sequence)
+);
+
+COMMENT ON COLUMN match_events.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN match_events.sequence IS 'Order of the event within the match timeline, starting at 1';
+COMMENT ON COLUMN match_events.type IS 'Type of the event (e.g., Try, Goal, PenaltyShot, FieldGoal, SinBin)';
+COMMENT ON COLUMN match_events.title IS 'Description of the event as shown in the match centre';
+COMMENT ON COLUMN match_events.game_seconds IS 'Seconds into the match the event occurred';
+COMMENT ON COLUMN match_events.team_id IS 'Foreign key referencing the team of the event, NULL for match events such as half time';
+COMMENT ON COLUMN match_events.player_id IS 'Identifier of the player involved, which may not have been named in a stored team list';
+COMMENT ON COLUMN match_events.homeTeam_score IS 'Score of the home team after the event';
+COMMENT ON COLUMN match_events.awayTeam_score IS 'Score of the away team after the event';
+
+CREATE TABLE match_team_stats (
+  fixture_id BIGINT NOT NULL REFERENCES fixtures(id) ON DELETE CASCADE,
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  category VARCHAR(255) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  value DOUBLE PRECISION,
+  PRIMARY KEY (fixture_id, team_id, category, name)
+);
+
+COMMENT ON COLUMN match_team_stats.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN match_team_stats.team_id IS 'Foreign key referencing teams table';
+COMMENT ON COLUMN match_team_stats.category IS 'Group the statistic is shown under (e.g., Possession, Attack)';
+COMMENT ON COLUMN match_team_stats.name IS 'Name of the statistic (e.g., Completion Rate)';
+COMMENT ON COLUMN match_team_stats.value IS 'Value of the statistic for the team';
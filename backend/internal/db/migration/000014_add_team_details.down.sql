@@ -0,0 +1,20 @@
+ALTER TABLE teams
+ADD COLUMN competition_id BIGINT REFERENCES competitions(id) ON DELETE CASCADE;
+
+UPDATE teams t
+SET competition_id = (SELECT MIN(competition_id) FROM team_competitions WHERE team_id = t.id);
+
+DELETE FROM teams
+WHERE competition_id IS NULL;
+
+ALTER TABLE teams
+ALTER COLUMN competition_id SET NOT NULL;
+
+DROP TABLE IF EXISTS team_competitions;
+
+ALTER TABLE teams
+DROP COLUMN logo_url,
+DROP COLUMN secondary_colour,
+DROP COLUMN primary_colour,
+DROP COLUMN code,
+DROP COLUMN name;
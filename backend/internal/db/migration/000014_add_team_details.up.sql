@@ -0,0 +1,46 @@
+ALTER TABLE teams
+ADD COLUMN name VARCHAR(255),
+ADD COLUMN code VARCHAR(10),
+ADD COLUMN primary_colour CHAR(7),
+ADD COLUMN secondary_colour CHAR(7),
+ADD COLUMN logo_url TEXT;
+
+COMMENT ON COLUMN teams.name IS 'Full name of the team (e.g., North Queensland Toyota Cowboys)';
+COMMENT ON COLUMN teams.code IS 'Short code for the team (e.g., NQL)';
+COMMENT ON COLUMN teams.primary_colour IS 'Primary theme colour of the team as a hex code (e.g., #002B5C)';
+COMMENT ON COLUMN teams.secondary_colour IS 'Secondary theme colour of the team as a hex code (e.g., #FFDD02)';
+COMMENT ON COLUMN teams.logo_url IS 'URL of the team badge';
+
+CREATE TABLE team_competitions (
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  competition_id BIGINT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+  season INTEGER NOT NULL,
+  PRIMARY KEY (team_id, competition_id, season)
+);
+
+COMMENT ON COLUMN team_competitions.team_id IS 'Foreign key referencing teams table';
+COMMENT ON COLUMN team_competitions.competition_id IS 'Foreign key referencing the competition the team played in';
+COMMENT ON COLUMN team_competitions.season IS 'Season the team played in the competition (e.g., 2024)';
+
+-- Teams previously belonged to a single competition, so record them in every
+-- season they have a fixture or a bye in.
+INSERT INTO team_competitions (team_id, competition_id, season)
+SELECT md.homeTeam_id, f.competition_id, f.season
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+UNION
+SELECT md.awayTeam_id, f.competition_id, f.season
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+UNION
+SELECT team_id, competition_id, season
+FROM round_byes;
+
+-- Teams without any fixtures are recorded in the current season of their competition.
+INSERT INTO team_competitions (team_id, competition_id, season)
+SELECT t.id, t.competition_id, COALESCE((SELECT MAX(season) FROM fixtures WHERE competition_id = t.competition_id), EXTRACT(YEAR FROM NOW())::INTEGER)
+FROM teams t
+WHERE NOT EXISTS (SELECT 1 FROM team_competitions tc WHERE tc.team_id = t.id);
+
+ALTER TABLE teams
+DROP COLUMN competition_id;
@@ -0,0 +1,4 @@
+ALTER TABLE fixtures
+DROP COLUMN venue_id;
+
+DROP TABLE IF EXISTS venues;
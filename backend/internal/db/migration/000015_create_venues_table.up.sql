@@ -0,0 +1,24 @@
+CREATE TABLE venues (
+  id SERIAL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  city VARCHAR(255) NOT NULL,
+  state VARCHAR(50),
+  country VARCHAR(255),
+  timezone VARCHAR(64),
+  UNIQUE (name, city)
+);
+
+COMMENT ON COLUMN venues.id IS 'Unique identifier for the venue';
+COMMENT ON COLUMN venues.name IS 'Name of the venue (e.g., Queensland Country Bank Stadium)';
+COMMENT ON COLUMN venues.city IS 'City where the venue is located (e.g., Townsville)';
+COMMENT ON COLUMN venues.state IS 'State or territory of the venue (e.g., QLD), NULL outside Australia and the United States';
+COMMENT ON COLUMN venues.country IS 'Country of the venue (e.g., Australia), NULL if its city is not located in config';
+COMMENT ON COLUMN venues.timezone IS 'IANA timezone of the venue (e.g., Australia/Brisbane), NULL if its city is not located in config';
+
+ALTER TABLE fixtures
+ADD COLUMN venue_id INTEGER REFERENCES venues(id);
+
+COMMENT ON COLUMN fixtures.venue_id IS 'Foreign key referencing venues table';
+
+-- Venues of existing fixtures are created from config.VenueLocations when the
+-- server or backfill starts (see NRLDataService.RefreshVenues).
@@ -0,0 +1,18 @@
+ALTER TABLE team_list_changes
+ALTER COLUMN detected_at TYPE TIMESTAMP WITHOUT TIME ZONE USING detected_at AT TIME ZONE 'UTC';
+
+ALTER TABLE ladder_entries
+ALTER COLUMN updated_at TYPE TIMESTAMP WITHOUT TIME ZONE USING updated_at AT TIME ZONE 'UTC';
+
+ALTER TABLE backfill_progress
+ALTER COLUMN completed_at TYPE TIMESTAMP WITHOUT TIME ZONE USING completed_at AT TIME ZONE 'UTC';
+
+ALTER TABLE nrl_response_cache
+ALTER COLUMN fetched_at TYPE TIMESTAMP WITHOUT TIME ZONE USING fetched_at AT TIME ZONE 'UTC';
+
+ALTER TABLE rounds
+ALTER COLUMN first_kickoff TYPE TIMESTAMP WITHOUT TIME ZONE USING first_kickoff AT TIME ZONE 'UTC',
+ALTER COLUMN last_kickoff TYPE TIMESTAMP WITHOUT TIME ZONE USING last_kickoff AT TIME ZONE 'UTC';
+
+ALTER TABLE fixtures
+ALTER COLUMN kickOffTime TYPE TIMESTAMP WITHOUT TIME ZONE USING kickOffTime AT TIME ZONE 'UTC';
@@ -0,0 +1,22 @@
+-- Times were stored without a time zone, holding the UTC wall clock time of the
+-- instant (kickoff times are parsed from the RFC3339 times of the NRL API in UTC,
+-- and NOW() defaults were written by sessions in the server's UTC time zone), so
+-- interpret the existing values as UTC to keep the same instants.
+ALTER TABLE fixtures
+ALTER COLUMN kickOffTime TYPE TIMESTAMPTZ USING kickOffTime AT TIME ZONE 'UTC';
+
+ALTER TABLE rounds
+ALTER COLUMN first_kickoff TYPE TIMESTAMPTZ USING first_kickoff AT TIME ZONE 'UTC',
+ALTER COLUMN last_kickoff TYPE TIMESTAMPTZ USING last_kickoff AT TIME ZONE 'UTC';
+
+ALTER TABLE nrl_response_cache
+ALTER COLUMN fetched_at TYPE TIMESTAMPTZ USING fetched_at AT TIME ZONE 'UTC';
+
+ALTER TABLE backfill_progress
+ALTER COLUMN completed_at TYPE TIMESTAMPTZ USING completed_at AT TIME ZONE 'UTC';
+
+ALTER TABLE ladder_entries
+ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+
+ALTER TABLE team_list_changes
+ALTER COLUMN detected_at TYPE TIMESTAMPTZ USING detected_at AT TIME ZONE 'UTC';
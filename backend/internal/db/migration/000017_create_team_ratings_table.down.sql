@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS fixture_predictions;
+DROP TABLE IF EXISTS team_ratings;
@@ -0,0 +1,32 @@
+CREATE TABLE team_ratings (
+  competition_id BIGINT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+  team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  rating DOUBLE PRECISION NOT NULL,
+  matches INTEGER NOT NULL,
+  season INTEGER NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (competition_id, team_id)
+);
+
+COMMENT ON COLUMN team_ratings.competition_id IS 'Foreign key referencing competitions table';
+COMMENT ON COLUMN team_ratings.team_id IS 'Foreign key referencing teams table';
+COMMENT ON COLUMN team_ratings.rating IS 'Elo rating of the team in the competition (e.g., 1532.4)';
+COMMENT ON COLUMN team_ratings.matches IS 'Number of completed matches the rating was calculated from';
+COMMENT ON COLUMN team_ratings.season IS 'Latest season the rating was carried into (e.g., 2024)';
+COMMENT ON COLUMN team_ratings.updated_at IS 'Time the rating was last recalculated';
+
+CREATE TABLE fixture_predictions (
+  fixture_id BIGINT PRIMARY KEY REFERENCES fixtures(id) ON DELETE CASCADE,
+  home_rating DOUBLE PRECISION NOT NULL,
+  away_rating DOUBLE PRECISION NOT NULL,
+  home_win_probability DOUBLE PRECISION NOT NULL,
+  predicted_margin DOUBLE PRECISION NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN fixture_predictions.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN fixture_predictions.home_rating IS 'Rating of the home team before the match';
+COMMENT ON COLUMN fixture_predictions.away_rating IS 'Rating of the away team before the match';
+COMMENT ON COLUMN fixture_predictions.home_win_probability IS 'Predicted probability of the home team winning, between 0 and 1';
+COMMENT ON COLUMN fixture_predictions.predicted_margin IS 'Predicted margin of the home team, negative when the away team is favoured';
+COMMENT ON COLUMN fixture_predictions.updated_at IS 'Time the prediction was last recalculated';
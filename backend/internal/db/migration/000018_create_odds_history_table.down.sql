@@ -0,0 +1 @@
+DROP TABLE IF EXISTS odds_history;
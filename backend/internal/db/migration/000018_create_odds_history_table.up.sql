@@ -0,0 +1,21 @@
+CREATE TABLE odds_history (
+  id SERIAL PRIMARY KEY,
+  fixture_id BIGINT NOT NULL REFERENCES fixtures(id) ON DELETE CASCADE,
+  homeTeam_odds FLOAT NOT NULL,
+  awayTeam_odds FLOAT NOT NULL,
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX odds_history_fixture_id_idx ON odds_history (fixture_id, recorded_at);
+
+COMMENT ON COLUMN odds_history.id IS 'Unique identifier for the odds record';
+COMMENT ON COLUMN odds_history.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN odds_history.homeTeam_odds IS 'Decimal odds for the home team winning';
+COMMENT ON COLUMN odds_history.awayTeam_odds IS 'Decimal odds for the away team winning';
+COMMENT ON COLUMN odds_history.recorded_at IS 'Time the odds were first seen in the NRL API';
+
+-- Earlier odds were overwritten, so the current odds are the earliest known.
+INSERT INTO odds_history (fixture_id, homeTeam_odds, awayTeam_odds)
+SELECT fixture_id, homeTeam_odds, awayTeam_odds
+FROM match_details
+WHERE homeTeam_odds IS NOT NULL AND awayTeam_odds IS NOT NULL;
@@ -0,0 +1,19 @@
+CREATE TABLE result_corrections (
+  id SERIAL PRIMARY KEY,
+  fixture_id BIGINT NOT NULL REFERENCES fixtures(id) ON DELETE CASCADE,
+  previous_homeTeam_score INT NOT NULL,
+  previous_awayTeam_score INT NOT NULL,
+  homeTeam_score INT NOT NULL,
+  awayTeam_score INT NOT NULL,
+  detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX result_corrections_fixture_id_idx ON result_corrections (fixture_id, detected_at);
+
+COMMENT ON COLUMN result_corrections.id IS 'Unique identifier for the correction';
+COMMENT ON COLUMN result_corrections.fixture_id IS 'Foreign key referencing fixtures table';
+COMMENT ON COLUMN result_corrections.previous_homeTeam_score IS 'Final score of the home team before the correction';
+COMMENT ON COLUMN result_corrections.previous_awayTeam_score IS 'Final score of the away team before the correction';
+COMMENT ON COLUMN result_corrections.homeTeam_score IS 'Corrected score of the home team';
+COMMENT ON COLUMN result_corrections.awayTeam_score IS 'Corrected score of the away team';
+COMMENT ON COLUMN result_corrections.detected_at IS 'Time the corrected result was first seen';
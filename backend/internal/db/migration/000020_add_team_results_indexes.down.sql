@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS fixtures_competition_kick_off_idx;
+DROP INDEX IF EXISTS match_details_away_team_idx;
+DROP INDEX IF EXISTS match_details_home_team_idx;
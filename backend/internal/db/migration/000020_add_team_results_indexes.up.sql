@@ -0,0 +1,5 @@
+-- The recent form of a team is looked up from its last results in a competition,
+-- so index the matches of each team and the fixtures by kickoff.
+CREATE INDEX match_details_home_team_idx ON match_details (homeTeam_id);
+CREATE INDEX match_details_away_team_idx ON match_details (awayTeam_id);
+CREATE INDEX fixtures_competition_kick_off_idx ON fixtures (competition_id, kickOffTime);
@@ -8,12 +8,32 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BackfillProgress struct {
+	// Foreign key referencing competitions table
+	CompetitionID int64
+	// Season the round belongs to (e.g., 2024)
+	Season int32
+	// Round number requested from the NRL draw
+	RoundNumber int32
+	// Number of fixtures imported for the round
+	Fixtures int32
+	// Time the round was fully imported
+	CompletedAt pgtype.Timestamptz
+}
+
 type Competition struct {
 	// Unique identifier for each competition
 	ID int64
 	// Name of the competition (e.g., NRL, NRLW)
 	Name string
-	// Indicates the current round or game for the competition (e.g., Round 1 for NRL, Game 1 for State of Origin)
+}
+
+type CompetitionSeason struct {
+	// Foreign key referencing competitions table
+	CompetitionID int64
+	// Season of the competition (e.g., 2024)
+	Season int32
+	// Indicates the current round or game for the season (e.g., Round 1 for NRL, Game 1 for State of Origin)
 	Round *string
 }
 
@@ -33,7 +53,61 @@ type Fixture struct {
 	// URL to the match center page
 	Matchcentreurl string
 	// Scheduled kickoff time of the match
-	Kickofftime pgtype.Timestamp
+	Kickofftime pgtype.Timestamptz
+	// Season the fixture belongs to (e.g., 2024)
+	Season int32
+	// Number of the round the fixture belongs to (e.g., 26)
+	RoundNumber int32
+	// Foreign key referencing venues table
+	VenueID *int32
+}
+
+type FixturePrediction struct {
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Rating of the home team before the match
+	HomeRating float64
+	// Rating of the away team before the match
+	AwayRating float64
+	// Predicted probability of the home team winning, between 0 and 1
+	HomeWinProbability float64
+	// Predicted margin of the home team, negative when the away team is favoured
+	PredictedMargin float64
+	// Time the prediction was last recalculated
+	UpdatedAt pgtype.Timestamptz
+}
+
+type LadderEntry struct {
+	// Foreign key referencing competitions table
+	CompetitionID int64
+	// Season of the ladder (e.g., 2024)
+	Season int32
+	// Round the ladder is as at, including the results of that round
+	RoundNumber int32
+	// Foreign key referencing teams table
+	TeamID int64
+	// Position of the team on the ladder, starting at 1
+	Position int32
+	// Number of matches played
+	Played int32
+	// Number of matches won
+	Wins int32
+	// Number of matches drawn
+	Draws int32
+	// Number of matches lost
+	Losses int32
+	// Number of byes
+	Byes int32
+	// Total points scored by the team
+	PointsFor int32
+	// Total points scored against the team
+	PointsAgainst int32
+	// Points for minus points against
+	PointsDifference int32
+	// Competition points (e.g., 2 for a win or bye, 1 for a draw)
+	Points int32
+	// Time the entry was last fetched from the NRL API
+	UpdatedAt pgtype.Timestamptz
 }
 
 type MatchDetail struct {
@@ -59,10 +133,212 @@ type MatchDetail struct {
 	WinnerTeamid *int64
 }
 
+type MatchEvent struct {
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Order of the event within the match timeline, starting at 1
+	Sequence int32
+	// Type of the event (e.g., Try, Goal, PenaltyShot, FieldGoal, SinBin)
+	Type string
+	// Description of the event as shown in the match centre
+	Title string
+	// Seconds into the match the event occurred
+	GameSeconds int32
+	// Foreign key referencing the team of the event, NULL for match events such as half time
+	TeamID *int64
+	// Identifier of the player involved, which may not have been named in a stored team list
+	PlayerID *int64
+	// Score of the home team after the event
+	HometeamScore *int32
+	// Score of the away team after the event
+	AwayteamScore *int32
+}
+
+type MatchTeamStat struct {
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Foreign key referencing teams table
+	TeamID int64
+	// Group the statistic is shown under (e.g., Possession, Attack)
+	Category string
+	// Name of the statistic (e.g., Completion Rate)
+	Name string
+	// Value of the statistic for the team
+	Value *float64
+}
+
+type NrlResponseCache struct {
+	// Full URL of the NRL API document
+	Url string
+	// ETag header returned with the document, if any
+	Etag *string
+	// Last-Modified header returned with the document, if any
+	LastModified *string
+	// SHA-256 hash of the document body
+	ContentHash string
+	// Raw body of the last fetched document
+	Body []byte
+	// Time the document was last fetched
+	FetchedAt pgtype.Timestamptz
+}
+
+type OddsHistory struct {
+	// Unique identifier for the odds record
+	ID int32
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Decimal odds for the home team winning
+	HometeamOdds float64
+	// Decimal odds for the away team winning
+	AwayteamOdds float64
+	// Time the odds were first seen in the NRL API
+	RecordedAt pgtype.Timestamptz
+}
+
+type Player struct {
+	// Unique identifier for the player, as used by the NRL API
+	ID int64
+	// First name of the player
+	FirstName string
+	// Last name of the player
+	LastName string
+}
+
+type ResultCorrection struct {
+	// Unique identifier for the correction
+	ID int32
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Final score of the home team before the correction
+	PreviousHometeamScore int32
+	// Final score of the away team before the correction
+	PreviousAwayteamScore int32
+	// Corrected score of the home team
+	HometeamScore int32
+	// Corrected score of the away team
+	AwayteamScore int32
+	// Time the corrected result was first seen
+	DetectedAt pgtype.Timestamptz
+}
+
+type Round struct {
+	// Foreign key referencing competitions table
+	CompetitionID int64
+	// Season the round belongs to (e.g., 2024)
+	Season int32
+	// Number of the round within the season (e.g., 26)
+	Number int32
+	// Title of the round (e.g., Round 26, Game 1, Finals Week 1)
+	Title string
+	// Type of the round (e.g., Regular, Finals, Origin)
+	Type string
+	// Kickoff time of the first fixture in the round
+	FirstKickoff pgtype.Timestamptz
+	// Kickoff time of the last fixture in the round
+	LastKickoff pgtype.Timestamptz
+	// URL friendly title used to address the round (e.g., round-26, finals-week-1, grand-final)
+	Slug string
+	// Week of the finals series the round is played in, starting at 1 (NULL for rounds outside the finals)
+	FinalsWeek *int32
+	// Multiplier applied to the points of tips on fixtures in the round
+	PointsWeight int32
+}
+
+type RoundBye struct {
+	// Competition of the round, part of the foreign key referencing rounds table
+	CompetitionID int64
+	// Season of the round, part of the foreign key referencing rounds table
+	Season int32
+	// Number of the round, part of the foreign key referencing rounds table
+	RoundNumber int32
+	// Foreign key referencing the team on the bye
+	TeamID int64
+}
+
 type Team struct {
 	// Unique identifier for each team
 	ID int64
 	// Nickname or short name for the team (e.g., Cowboys)
-	Nickname      string
+	Nickname string
+	// Full name of the team (e.g., North Queensland Toyota Cowboys)
+	Name *string
+	// Short code for the team (e.g., NQL)
+	Code *string
+	// Primary theme colour of the team as a hex code (e.g., #002B5C)
+	PrimaryColour *string
+	// Secondary theme colour of the team as a hex code (e.g., #FFDD02)
+	SecondaryColour *string
+	// URL of the team badge
+	LogoUrl *string
+}
+
+type TeamCompetition struct {
+	// Foreign key referencing teams table
+	TeamID int64
+	// Foreign key referencing the competition the team played in
+	CompetitionID int64
+	// Season the team played in the competition (e.g., 2024)
+	Season int32
+}
+
+type TeamList struct {
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Foreign key referencing the team the player is named in
+	TeamID int64
+	// Foreign key referencing players table
+	PlayerID int64
+	// Jersey number the player is named in (e.g., 1)
+	Number int32
+	// Position the player is named in (e.g., Fullback, Interchange, Reserve)
+	Position string
+}
+
+type TeamListChange struct {
+	// Unique identifier for the change
+	ID int32
+	// Foreign key referencing fixtures table
+	FixtureID int64
+	// Foreign key referencing the team whose list changed
+	TeamID int64
+	// Foreign key referencing the player affected by the change
+	PlayerID int64
+	// Type of change (e.g., In, Out, Position)
+	Change string
+	// Jersey number of the player after the change, or before it for players who are out
+	Number int32
+	// Position of the player after the change, or before it for players who are out
+	Position string
+	// Time the change was first seen in the NRL API
+	DetectedAt pgtype.Timestamptz
+}
+
+type TeamRating struct {
+	// Foreign key referencing competitions table
 	CompetitionID int64
+	// Foreign key referencing teams table
+	TeamID int64
+	// Elo rating of the team in the competition (e.g., 1532.4)
+	Rating float64
+	// Number of completed matches the rating was calculated from
+	Matches int32
+	// Latest season the rating was carried into (e.g., 2024)
+	Season int32
+	// Time the rating was last recalculated
+	UpdatedAt pgtype.Timestamptz
+}
+
+type Venue struct {
+	// Unique identifier for the venue
+	ID int32
+	// Name of the venue (e.g., Queensland Country Bank Stadium)
+	Name string
+	// City where the venue is located (e.g., Townsville)
+	City string
+	// State or territory of the venue (e.g., QLD), NULL outside Australia and the United States
+	State *string
+	// Country of the venue (e.g., Australia), NULL if its city is not located in config
+	Country *string
+	// IANA timezone of the venue (e.g., Australia/Brisbane), NULL if its city is not located in config
+	Timezone *string
 }
@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: nrl_response_cache.sql
+
+package db
+
+import (
+	"context"
+)
+
+const deleteNRLResponseCache = `-- name: DeleteNRLResponseCache :exec
+DELETE FROM nrl_response_cache
+WHERE url = $1
+`
+
+// Remove the cached response for an NRL API URL so the next fetch treats the
+// document as changed.
+func (q *Queries) DeleteNRLResponseCache(ctx context.Context, url string) error {
+	_, err := q.db.Exec(ctx, deleteNRLResponseCache, url)
+	return err
+}
+
+const getNRLResponseCache = `-- name: GetNRLResponseCache :one
+
+SELECT url, etag, last_modified, content_hash, body, fetched_at FROM nrl_response_cache WHERE url = $1
+`
+
+// The nrl_response_cache table stores the last response for each document
+// fetched from the NRL API, so unchanged documents can be detected with
+// conditional requests and content hashes.
+// Retrieve the cached response for a specific NRL API URL.
+func (q *Queries) GetNRLResponseCache(ctx context.Context, url string) (*NrlResponseCache, error) {
+	row := q.db.QueryRow(ctx, getNRLResponseCache, url)
+	var i NrlResponseCache
+	err := row.Scan(
+		&i.Url,
+		&i.Etag,
+		&i.LastModified,
+		&i.ContentHash,
+		&i.Body,
+		&i.FetchedAt,
+	)
+	return &i, err
+}
+
+const touchNRLResponseCache = `-- name: TouchNRLResponseCache :exec
+UPDATE nrl_response_cache
+SET fetched_at = NOW()
+WHERE url = $1
+`
+
+// Mark a cached response as fetched again without changing its content.
+func (q *Queries) TouchNRLResponseCache(ctx context.Context, url string) error {
+	_, err := q.db.Exec(ctx, touchNRLResponseCache, url)
+	return err
+}
+
+const upsertNRLResponseCache = `-- name: UpsertNRLResponseCache :one
+INSERT INTO nrl_response_cache (
+  url, etag, last_modified, content_hash, body, fetched_at
+) VALUES (
+  $1, $2, $3, $4, $5, NOW()
+)
+ON CONFLICT (url) DO UPDATE
+SET 
+  etag = EXCLUDED.etag,
+  last_modified = EXCLUDED.last_modified,
+  content_hash = EXCLUDED.content_hash,
+  body = EXCLUDED.body,
+  fetched_at = EXCLUDED.fetched_at
+RETURNING url, etag, last_modified, content_hash, body, fetched_at
+`
+
+type UpsertNRLResponseCacheParams struct {
+	Url          string
+	Etag         *string
+	LastModified *string
+	ContentHash  string
+	Body         []byte
+}
+
+// Insert or replace the cached response for an NRL API URL.
+func (q *Queries) UpsertNRLResponseCache(ctx context.Context, arg UpsertNRLResponseCacheParams) (*NrlResponseCache, error) {
+	row := q.db.QueryRow(ctx, upsertNRLResponseCache,
+		arg.Url,
+		arg.Etag,
+		arg.LastModified,
+		arg.ContentHash,
+		arg.Body,
+	)
+	var i NrlResponseCache
+	err := row.Scan(
+		&i.Url,
+		&i.Etag,
+		&i.LastModified,
+		&i.ContentHash,
+		&i.Body,
+		&i.FetchedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,94 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: odds_history.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createOddsHistory = `-- name: CreateOddsHistory :one
+INSERT INTO odds_history (
+  fixture_id, homeTeam_odds, awayTeam_odds
+) VALUES (
+  $1, $2, $3
+)
+RETURNING id, fixture_id, hometeam_odds, awayteam_odds, recorded_at
+`
+
+type CreateOddsHistoryParams struct {
+	FixtureID    int64
+	HometeamOdds float64
+	AwayteamOdds float64
+}
+
+// Record the odds of a fixture as at now.
+func (q *Queries) CreateOddsHistory(ctx context.Context, arg CreateOddsHistoryParams) (*OddsHistory, error) {
+	row := q.db.QueryRow(ctx, createOddsHistory, arg.FixtureID, arg.HometeamOdds, arg.AwayteamOdds)
+	var i OddsHistory
+	err := row.Scan(
+		&i.ID,
+		&i.FixtureID,
+		&i.HometeamOdds,
+		&i.AwayteamOdds,
+		&i.RecordedAt,
+	)
+	return &i, err
+}
+
+const getLatestOddsByFixtureID = `-- name: GetLatestOddsByFixtureID :one
+SELECT id, fixture_id, hometeam_odds, awayteam_odds, recorded_at FROM odds_history
+WHERE fixture_id = $1
+ORDER BY recorded_at DESC, id DESC
+LIMIT 1
+`
+
+// Retrieve the most recently recorded odds of a fixture.
+func (q *Queries) GetLatestOddsByFixtureID(ctx context.Context, fixtureID int64) (*OddsHistory, error) {
+	row := q.db.QueryRow(ctx, getLatestOddsByFixtureID, fixtureID)
+	var i OddsHistory
+	err := row.Scan(
+		&i.ID,
+		&i.FixtureID,
+		&i.HometeamOdds,
+		&i.AwayteamOdds,
+		&i.RecordedAt,
+	)
+	return &i, err
+}
+
+const listOpeningOddsByFixtureIDs = `-- name: ListOpeningOddsByFixtureIDs :many
+SELECT DISTINCT ON (fixture_id) id, fixture_id, hometeam_odds, awayteam_odds, recorded_at 
+FROM odds_history
+WHERE fixture_id = ANY($1::BIGINT[])
+ORDER BY fixture_id, recorded_at, id
+`
+
+// Retrieve the first recorded odds of each of a set of fixtures.
+func (q *Queries) ListOpeningOddsByFixtureIDs(ctx context.Context, fixtureIds []int64) ([]*OddsHistory, error) {
+	rows, err := q.db.Query(ctx, listOpeningOddsByFixtureIDs, fixtureIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*OddsHistory
+	for rows.Next() {
+		var i OddsHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.FixtureID,
+			&i.HometeamOdds,
+			&i.AwayteamOdds,
+			&i.RecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -9,19 +9,49 @@ import (
 )
 
 type Querier interface {
+	// Record that a team played in a competition season.
+	// If it has already been recorded, do nothing.
+	AddTeamCompetition(ctx context.Context, arg AddTeamCompetitionParams) error
 	// Insert a new fixture into the fixtures table.
 	// This query adds a new fixture record with the specified details, such as
-	// competition ID, round title, match state, venue, venue city, match center URL,
-	// and kickoff time.
+	// competition ID, season, round number, round title, match state, venue, venue
+	// city, venue ID, match center URL, and kickoff time.
 	CreateFixture(ctx context.Context, arg CreateFixtureParams) (*Fixture, error)
 	// Insert a new match detail record into the match_details table.
 	// If a match detail with the same fixture_id already exists, do nothing.
 	CreateMatchDetail(ctx context.Context, arg CreateMatchDetailParams) (*MatchDetail, error)
+	// Insert an event into the timeline of a fixture.
+	CreateMatchEvent(ctx context.Context, arg CreateMatchEventParams) error
+	// Record the odds of a fixture as at now.
+	CreateOddsHistory(ctx context.Context, arg CreateOddsHistoryParams) (*OddsHistory, error)
+	// Record a change to the final score of a completed fixture.
+	CreateResultCorrection(ctx context.Context, arg CreateResultCorrectionParams) (*ResultCorrection, error)
+	// Record a team as being on the bye for a round.
+	// If the team is already recorded for the round, do nothing.
+	CreateRoundBye(ctx context.Context, arg CreateRoundByeParams) error
 	// Insert a new team into the teams table.
-	// If a team with the same team_id already exists, do nothing.
 	CreateTeam(ctx context.Context, arg CreateTeamParams) (*Team, error)
+	// Record a change to a team's list after it was first announced.
+	CreateTeamListChange(ctx context.Context, arg CreateTeamListChangeParams) error
+	// Name a player in a team's list for a fixture.
+	CreateTeamListPlayer(ctx context.Context, arg CreateTeamListPlayerParams) error
+	// Remove the timeline of a fixture, before storing the latest timeline.
+	DeleteMatchEvents(ctx context.Context, fixtureID int64) error
+	// Remove the cached response for an NRL API URL so the next fetch treats the
+	// document as changed.
+	DeleteNRLResponseCache(ctx context.Context, url string) error
+	// Remove all teams on the bye for a round, before storing the latest byes.
+	DeleteRoundByes(ctx context.Context, arg DeleteRoundByesParams) error
+	// Remove a player from a team's list for a fixture.
+	DeleteTeamListPlayer(ctx context.Context, arg DeleteTeamListPlayerParams) error
+	// The backfill_progress table records which rounds of historical seasons have
+	// been fully imported, so the backfill command can be stopped and resumed.
+	// Retrieve the import progress for a specific competition, season and round.
+	GetBackfillProgress(ctx context.Context, arg GetBackfillProgressParams) (*BackfillProgress, error)
 	// Retrieve a specific competition by its unique identifier.
 	GetCompetitionByID(ctx context.Context, id int64) (*Competition, error)
+	// Retrieve the state of a specific season of a competition.
+	GetCompetitionSeason(ctx context.Context, arg GetCompetitionSeasonParams) (*CompetitionSeason, error)
 	// Retrieve a specific fixture by its unique identifier.
 	// Useful for fetching details about a single fixture based on its ID.
 	GetFixtureByID(ctx context.Context, id int64) (*Fixture, error)
@@ -29,10 +59,36 @@ type Querier interface {
 	// This query fetches all fixtures for a given competition ID, ordered by their
 	// kickoff time to display them in chronological order.
 	GetFixturesByCompetitionID(ctx context.Context, competitionID int64) ([]*Fixture, error)
+	// Retrieve the most recently recorded odds of a fixture.
+	GetLatestOddsByFixtureID(ctx context.Context, fixtureID int64) (*OddsHistory, error)
 	// Retrieve match details for a specific fixture by its unique fixture ID.
 	GetMatchDetailsByFixtureID(ctx context.Context, fixtureID int64) (*GetMatchDetailsByFixtureIDRow, error)
+	// The nrl_response_cache table stores the last response for each document
+	// fetched from the NRL API, so unchanged documents can be detected with
+	// conditional requests and content hashes.
+	// Retrieve the cached response for a specific NRL API URL.
+	GetNRLResponseCache(ctx context.Context, url string) (*NrlResponseCache, error)
+	// Retrieve the finals week that follows the finals rounds played before the
+	// given round number, or 1 if no finals rounds have been played yet.
+	GetNextFinalsWeek(ctx context.Context, arg GetNextFinalsWeekParams) (int32, error)
+	// Retrieve the next fixture between two teams that kicks off after a time.
+	GetNextHeadToHeadFixture(ctx context.Context, arg GetNextHeadToHeadFixtureParams) (*Fixture, error)
+	// Retrieve a round of a competition season by its slug (e.g., grand-final).
+	// If no season is given, the season of the competition's current round is used,
+	// or its latest season if it has no current round.
+	GetRoundBySlug(ctx context.Context, arg GetRoundBySlugParams) (*Round, error)
 	// Retrieve a specific team by its unique identifier.
 	GetTeamByID(ctx context.Context, id int64) (*Team, error)
+	// Retrieve a team of a competition by its nickname (e.g., Cowboys).
+	GetTeamByNickname(ctx context.Context, arg GetTeamByNicknameParams) (*Team, error)
+	// Retrieve a specific venue by its unique identifier.
+	GetVenueByID(ctx context.Context, id int32) (*Venue, error)
+	// Link the fixtures played at a venue, which are not linked yet, to the venue.
+	LinkFixturesToVenue(ctx context.Context, arg LinkFixturesToVenueParams) (int64, error)
+	// Retrieve every round of every competition season.
+	ListAllRounds(ctx context.Context) ([]*Round, error)
+	// Retrieve all imported rounds for a competition, ordered by season and round.
+	ListBackfillProgressByCompetitionID(ctx context.Context, competitionID int64) ([]*BackfillProgress, error)
 	// The competitions table is a static table that stores information about the
 	// competitions that are available in the system. Other tables in the system
 	// reference this table to establish a relationship.
@@ -40,23 +96,118 @@ type Querier interface {
 	ListCompetitions(ctx context.Context) ([]*Competition, error)
 	// Retrieve all match details for a specific competition ID.
 	// This query performs a JOIN between match_details and fixtures to get all
-	// match details that are part of a specific competition and round.
-	ListCurrentRoundMatchDetailsByCompetitionID(ctx context.Context, id int64) ([]*ListCurrentRoundMatchDetailsByCompetitionIDRow, error)
+	// match details that are part of the current round of a competition season.
+	// If no season is given, the season of the competition's current round is used,
+	// or its latest season if it has no current round.
+	ListCurrentRoundMatchDetailsByCompetitionID(ctx context.Context, arg ListCurrentRoundMatchDetailsByCompetitionIDParams) ([]*ListCurrentRoundMatchDetailsByCompetitionIDRow, error)
+	// Retrieve the predictions of a set of fixtures.
+	ListFixturePredictionsByFixtureIDs(ctx context.Context, fixtureIds []int64) ([]*FixturePrediction, error)
 	// Retrieve all fixtures available in the system.
 	// This query is used to list all fixtures without filtering by any criteria.
 	ListFixtures(ctx context.Context) ([]*Fixture, error)
-	// Retrieve all match details available in the system.
-	ListMatchDetails(ctx context.Context) ([]*ListMatchDetailsRow, error)
+	// Retrieve every completed match between two teams, newest first, regardless of
+	// which of them was the home team.
+	ListHeadToHeadResults(ctx context.Context, arg ListHeadToHeadResultsParams) ([]*ListHeadToHeadResultsRow, error)
+	// Retrieve the number of byes each team has had in a competition season up to
+	// and including a round, used to recalculate the ladder.
+	ListLadderByesByCompetitionID(ctx context.Context, arg ListLadderByesByCompetitionIDParams) ([]*ListLadderByesByCompetitionIDRow, error)
+	// Retrieve the ladder of a competition as at a round, ordered by position.
+	// If no season is given, the season of the competition's current round is used,
+	// or its latest season if it has no current round, and if no round is given, the
+	// latest round with a ladder in that season is used.
+	ListLadderEntriesByCompetitionID(ctx context.Context, arg ListLadderEntriesByCompetitionIDParams) ([]*ListLadderEntriesByCompetitionIDRow, error)
+	// Retrieve the scores of every completed regular season match of a competition
+	// season up to and including a round, used to recalculate the ladder.
+	ListLadderResultsByCompetitionID(ctx context.Context, arg ListLadderResultsByCompetitionIDParams) ([]*ListLadderResultsByCompetitionIDRow, error)
+	// Retrieve all match details available in the system for a season.
+	// If no season is given, each competition defaults to the season of its current
+	// round, or its latest season if it has no current round.
+	ListMatchDetails(ctx context.Context, season *int32) ([]*ListMatchDetailsRow, error)
 	// Retrieve all match details for a specific competition ID.
 	// This query performs a JOIN between match_details and fixtures to get all
-	// match details that are part of a specific competition.
-	ListMatchDetailsByCompetitionID(ctx context.Context, competitionID int64) ([]*ListMatchDetailsByCompetitionIDRow, error)
+	// match details that are part of a specific competition and season. If no
+	// season is given, the season of the competition's current round is used, or its
+	// latest season if it has no current round.
+	ListMatchDetailsByCompetitionID(ctx context.Context, arg ListMatchDetailsByCompetitionIDParams) ([]*ListMatchDetailsByCompetitionIDRow, error)
+	// Retrieve the timeline of a fixture in order, with the name of the player
+	// involved in each event if they are known.
+	ListMatchEventsByFixtureID(ctx context.Context, fixtureID int64) ([]*ListMatchEventsByFixtureIDRow, error)
+	// Retrieve the statistics of both teams of a fixture.
+	ListMatchTeamStatsByFixtureID(ctx context.Context, fixtureID int64) ([]*MatchTeamStat, error)
+	// Retrieve the first recorded odds of each of a set of fixtures.
+	ListOpeningOddsByFixtureIDs(ctx context.Context, fixtureIds []int64) ([]*OddsHistory, error)
+	// Retrieve the prediction, bookmaker odds and scores of every completed match of
+	// a competition season, used to compare the calibration of the predictions. If
+	// no season is given, the season of the competition's current round is used, or
+	// its latest season if it has no current round.
+	ListPredictionResultsByCompetitionID(ctx context.Context, arg ListPredictionResultsByCompetitionIDParams) ([]*ListPredictionResultsByCompetitionIDRow, error)
+	// Retrieve every fixture of a competition with its teams and scores, in the
+	// order they kicked off, used to replay the results into team ratings.
+	ListRatingFixturesByCompetitionID(ctx context.Context, competitionID int64) ([]*ListRatingFixturesByCompetitionIDRow, error)
+	// Retrieve the corrections to the result of a fixture, oldest first.
+	ListResultCorrectionsByFixtureID(ctx context.Context, fixtureID int64) ([]*ResultCorrection, error)
+	// Retrieve the teams on the bye for every round of a competition season.
+	// If no season is given, the season of the competition's current round is used,
+	// or its latest season if it has no current round.
+	ListRoundByesByCompetitionID(ctx context.Context, arg ListRoundByesByCompetitionIDParams) ([]*ListRoundByesByCompetitionIDRow, error)
 	// Retrieve all match details for a specific competition ID.
 	// This query performs a JOIN between match_details and fixtures to get all
-	// match details that are part of a specific competition and round.
+	// match details that are part of a specific competition, season and round. If
+	// no season is given, the season of the competition's current round is used, or
+	// its latest season if it has no current round.
 	ListRoundMatchDetailsByCompetitionID(ctx context.Context, arg ListRoundMatchDetailsByCompetitionIDParams) ([]*ListRoundMatchDetailsByCompetitionIDRow, error)
+	// Retrieve all rounds of a competition season, ordered by round number.
+	// If no season is given, the season of the competition's current round is used,
+	// or its latest season if it has no current round.
+	ListRoundsByCompetitionID(ctx context.Context, arg ListRoundsByCompetitionIDParams) ([]*Round, error)
+	// Retrieve every game of a series competition (e.g. State of Origin) up to and
+	// including a season, oldest first, with the scores of the games played. If no
+	// season is given, every season is included.
+	ListSeriesFixturesByCompetitionID(ctx context.Context, arg ListSeriesFixturesByCompetitionIDParams) ([]*ListSeriesFixturesByCompetitionIDRow, error)
+	// Retrieve every competition season of every team, ordered by team, competition and season.
+	ListTeamCompetitions(ctx context.Context) ([]*TeamCompetition, error)
+	// Retrieve the competition seasons a team has played in, ordered by competition and season.
+	ListTeamCompetitionsByTeamID(ctx context.Context, teamID int64) ([]*TeamCompetition, error)
+	// Retrieve the last completed matches of each of a set of teams in a competition
+	// before a time, newest first, used to calculate the recent form of teams. Each
+	// team is given with the kickoff time of the fixture its form is needed for.
+	ListTeamFormResults(ctx context.Context, arg ListTeamFormResultsParams) ([]*ListTeamFormResultsRow, error)
+	// Retrieve the venues a team has played home matches at, with the number of
+	// home matches played at each, most used first.
+	ListTeamHomeGrounds(ctx context.Context, hometeamID int64) ([]*ListTeamHomeGroundsRow, error)
+	// Retrieve the changes to both team lists of a fixture, in the order they were detected.
+	ListTeamListChangesByFixtureID(ctx context.Context, fixtureID int64) ([]*ListTeamListChangesByFixtureIDRow, error)
+	// Retrieve the players named by both teams of a fixture, ordered by team and jersey number.
+	ListTeamListsByFixtureID(ctx context.Context, fixtureID int64) ([]*ListTeamListsByFixtureIDRow, error)
+	// Retrieve the ratings of the teams in a competition, highest rated first.
+	ListTeamRatingsByCompetitionID(ctx context.Context, competitionID int64) ([]*ListTeamRatingsByCompetitionIDRow, error)
 	// Retrieve all teams available in the system.
 	ListTeams(ctx context.Context) ([]*Team, error)
+	// Retrieve all teams that have played in a competition in any season.
+	ListTeamsByCompetitionID(ctx context.Context, competitionID int64) ([]*Team, error)
+	// Retrieve the distinct venues of fixtures which are not linked to a venue.
+	ListUnlinkedFixtureVenues(ctx context.Context) ([]*ListUnlinkedFixtureVenuesRow, error)
+	// Retrieve all venues ordered by country, city and name.
+	ListVenues(ctx context.Context) ([]*Venue, error)
+	// Record a round as fully imported, replacing any previous record for it.
+	MarkBackfillRoundComplete(ctx context.Context, arg MarkBackfillRoundCompleteParams) (*BackfillProgress, error)
+	// Recalculate the first and last kickoff times of a round from its fixtures.
+	RefreshRoundKickOffs(ctx context.Context, arg RefreshRoundKickOffsParams) (*Round, error)
+	// Mark a cached response as fetched again without changing its content.
+	TouchNRLResponseCache(ctx context.Context, url string) error
+	// Conditionally update fixture details based on provided arguments.
+	// This query updates the fields of a fixture record where the provided arguments
+	// are not NULL. It uses the COALESCE function to retain the existing value if
+	// the argument is NULL.
+	UpdateFixture(ctx context.Context, arg UpdateFixtureParams) (*Fixture, error)
+	// Conditionally update match detail fields based on provided arguments.
+	// Only updates fields where the argument is not NULL, except the winner, which
+	// is set whenever both scores are given so that a draw clears a previous winner.
+	UpdateMatchDetail(ctx context.Context, arg UpdateMatchDetailParams) (*MatchDetail, error)
+	// Set the multiplier applied to the points of tips on fixtures in a round.
+	UpdateRoundPointsWeight(ctx context.Context, arg UpdateRoundPointsWeightParams) error
+	// Update the jersey number and position a player is named in for a fixture.
+	UpdateTeamListPlayer(ctx context.Context, arg UpdateTeamListPlayerParams) error
 	// The following commands for creating, updating, and deleting competitions
 	// are not required since this is a static table with fixed records:
 	// - NRL (111)
@@ -66,18 +217,32 @@ type Querier interface {
 	//
 	// However, if future updates to this table are needed (e.g., new competitions),
 	// you may add additional commands to handle such changes.
-	// Update the current round for a competition.
-	// This query updates the round field for a specific competition based on the
-	// provided competition ID.
-	UpdateCompetitionRound(ctx context.Context, arg UpdateCompetitionRoundParams) (*Competition, error)
-	// Conditionally update fixture details based on provided arguments.
-	// This query updates the fields of a fixture record where the provided arguments
-	// are not NULL. It uses the COALESCE function to retain the existing value if
-	// the argument is NULL.
-	UpdateFixture(ctx context.Context, arg UpdateFixtureParams) (*Fixture, error)
-	// Conditionally update match detail fields based on provided arguments.
-	// Only updates fields where the argument is not NULL.
-	UpdateMatchDetail(ctx context.Context, arg UpdateMatchDetailParams) (*MatchDetail, error)
+	// Set the current round for a season of a competition.
+	// This query creates the season record if it does not exist, otherwise it
+	// updates the round field for the season.
+	UpsertCompetitionSeasonRound(ctx context.Context, arg UpsertCompetitionSeasonRoundParams) (*CompetitionSeason, error)
+	// Insert the predictions of a set of fixtures, or update those that already
+	// exist and have changed.
+	UpsertFixturePredictions(ctx context.Context, arg UpsertFixturePredictionsParams) error
+	// Insert a team's ladder entry for a round, or update it if it already exists.
+	UpsertLadderEntry(ctx context.Context, arg UpsertLadderEntryParams) (*LadderEntry, error)
+	// Insert a team's statistic for a fixture, or update its value if it already exists.
+	UpsertMatchTeamStat(ctx context.Context, arg UpsertMatchTeamStatParams) error
+	// Insert or replace the cached response for an NRL API URL.
+	UpsertNRLResponseCache(ctx context.Context, arg UpsertNRLResponseCacheParams) (*NrlResponseCache, error)
+	// Insert a player, or update the name of an existing player.
+	UpsertPlayer(ctx context.Context, arg UpsertPlayerParams) (*Player, error)
+	// Insert a round, or update the title, type and finals metadata of an existing round.
+	UpsertRound(ctx context.Context, arg UpsertRoundParams) (*Round, error)
+	// Insert a team, or update the details of an existing team. Details that are
+	// not given keep their existing value, so a team can be stored from a source
+	// which only has its nickname.
+	UpsertTeam(ctx context.Context, arg UpsertTeamParams) (*Team, error)
+	// Insert the ratings of a set of teams in a competition, or update those that
+	// already exist and have changed.
+	UpsertTeamRatings(ctx context.Context, arg UpsertTeamRatingsParams) error
+	// Insert a venue, or update the location of an existing venue, and return it.
+	UpsertVenue(ctx context.Context, arg UpsertVenueParams) (*Venue, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,29 @@
+-- The backfill_progress table records which rounds of historical seasons have
+-- been fully imported, so the backfill command can be stopped and resumed.
+
+-- name: GetBackfillProgress :one
+-- Retrieve the import progress for a specific competition, season and round.
+SELECT * FROM backfill_progress
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND round_number = $3;
+
+-- name: ListBackfillProgressByCompetitionID :many
+-- Retrieve all imported rounds for a competition, ordered by season and round.
+SELECT * FROM backfill_progress
+WHERE competition_id = $1
+ORDER BY season, round_number;
+
+-- name: MarkBackfillRoundComplete :one
+-- Record a round as fully imported, replacing any previous record for it.
+INSERT INTO backfill_progress (
+  competition_id, season, round_number, fixtures, completed_at
+) VALUES (
+  $1, $2, $3, $4, NOW()
+)
+ON CONFLICT (competition_id, season, round_number) DO UPDATE
+SET 
+  fixtures = EXCLUDED.fixtures,
+  completed_at = EXCLUDED.completed_at
+RETURNING *;
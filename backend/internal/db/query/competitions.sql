@@ -21,11 +21,19 @@ SELECT * FROM competitions WHERE id = $1;
 -- you may add additional commands to handle such changes.
 
 
--- name: UpdateCompetitionRound :one
--- Update the current round for a competition.
--- This query updates the round field for a specific competition based on the
--- provided competition ID.
-UPDATE competitions
-SET round = $2
-WHERE id = $1
-RETURNING *;
\ No newline at end of file
+-- name: UpsertCompetitionSeasonRound :one
+-- Set the current round for a season of a competition.
+-- This query creates the season record if it does not exist, otherwise it
+-- updates the round field for the season.
+INSERT INTO competition_seasons (competition_id, season, round)
+VALUES ($1, $2, $3)
+ON CONFLICT (competition_id, season) DO UPDATE
+SET round = EXCLUDED.round
+RETURNING *;
+
+-- name: GetCompetitionSeason :one
+-- Retrieve the state of a specific season of a competition.
+SELECT * FROM competition_seasons 
+WHERE 
+  competition_id = $1
+  AND season = $2;
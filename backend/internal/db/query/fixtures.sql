@@ -20,12 +20,12 @@ ORDER BY kickOffTime;
 -- name: CreateFixture :one
 -- Insert a new fixture into the fixtures table.
 -- This query adds a new fixture record with the specified details, such as
--- competition ID, round title, match state, venue, venue city, match center URL,
--- and kickoff time.
+-- competition ID, season, round number, round title, match state, venue, venue
+-- city, venue ID, match center URL, and kickoff time.
 INSERT INTO fixtures (
-  id, competition_id, roundTitle, matchState, venue, venueCity, matchCentreUrl, kickOffTime
+  id, competition_id, season, round_number, roundTitle, matchState, venue, venueCity, venue_id, matchCentreUrl, kickOffTime
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 RETURNING *;
 
@@ -35,6 +35,10 @@ RETURNING *;
 -- are not NULL. It uses the COALESCE function to retain the existing value if 
 -- the argument is NULL.
 UPDATE fixtures 
-SET matchState = COALESCE(sqlc.narg('matchState'), matchState)
+SET matchState = COALESCE(sqlc.narg('matchState'), matchState),
+    kickOffTime = COALESCE(sqlc.narg('kickOffTime'), kickOffTime),
+    venue = COALESCE(sqlc.narg('venue'), venue),
+    venueCity = COALESCE(sqlc.narg('venueCity'), venueCity),
+    venue_id = COALESCE(sqlc.narg('venue_id'), venue_id)
 WHERE id = $1
 RETURNING *;
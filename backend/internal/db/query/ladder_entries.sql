@@ -0,0 +1,75 @@
+-- name: ListLadderEntriesByCompetitionID :many
+-- Retrieve the ladder of a competition as at a round, ordered by position.
+-- If no season is given, the season of the competition's current round is used,
+-- or its latest season if it has no current round, and if no round is given, the
+-- latest round with a ladder in that season is used.
+SELECT 
+  sqlc.embed(le), 
+  sqlc.embed(t)
+FROM ladder_entries le
+JOIN teams t ON le.team_id = t.id
+WHERE 
+  le.competition_id = $1
+  AND le.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = le.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM ladder_entries WHERE competition_id = le.competition_id)
+  )
+  AND le.round_number = COALESCE(sqlc.narg('round')::INTEGER, (SELECT MAX(round_number) FROM ladder_entries WHERE competition_id = le.competition_id AND season = le.season))
+ORDER BY le.position;
+
+-- name: UpsertLadderEntry :one
+-- Insert a team's ladder entry for a round, or update it if it already exists.
+INSERT INTO ladder_entries (
+  competition_id, season, round_number, team_id, position, played, wins, draws, 
+  losses, byes, points_for, points_against, points_difference, points
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+ON CONFLICT (competition_id, season, round_number, team_id) DO UPDATE
+SET 
+  position = EXCLUDED.position,
+  played = EXCLUDED.played,
+  wins = EXCLUDED.wins,
+  draws = EXCLUDED.draws,
+  losses = EXCLUDED.losses,
+  byes = EXCLUDED.byes,
+  points_for = EXCLUDED.points_for,
+  points_against = EXCLUDED.points_against,
+  points_difference = EXCLUDED.points_difference,
+  points = EXCLUDED.points,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: ListLadderResultsByCompetitionID :many
+-- Retrieve the scores of every completed regular season match of a competition
+-- season up to and including a round, used to recalculate the ladder.
+SELECT 
+  md.homeTeam_id,
+  md.awayTeam_id,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+WHERE 
+  f.competition_id = $1
+  AND f.season = $2
+  AND f.round_number <= sqlc.arg('round')
+  AND f.matchState = 'FullTime'
+  AND r.type = 'Regular'
+  AND md.homeTeam_score IS NOT NULL
+  AND md.awayTeam_score IS NOT NULL;
+
+-- name: ListLadderByesByCompetitionID :many
+-- Retrieve the number of byes each team has had in a competition season up to
+-- and including a round, used to recalculate the ladder.
+SELECT 
+  team_id,
+  COUNT(*)::INTEGER AS byes
+FROM round_byes
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND round_number <= sqlc.arg('round')
+GROUP BY team_id;
@@ -4,80 +4,218 @@ SELECT
   sqlc.embed(md), 
   sqlc.embed(f), 
   sqlc.embed(home_team), 
-  sqlc.embed(away_team)
+  sqlc.embed(away_team),
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
 WHERE md.fixture_id = $1
 ORDER BY f.kickOffTime;
 
 -- name: ListMatchDetails :many
--- Retrieve all match details available in the system.
+-- Retrieve all match details available in the system for a season.
+-- If no season is given, each competition defaults to the season of its current
+-- round, or its latest season if it has no current round.
 SELECT 
   sqlc.embed(md), 
   sqlc.embed(f), 
   sqlc.embed(home_team), 
-  sqlc.embed(away_team)
+  sqlc.embed(away_team),
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
+WHERE f.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime;
 
 -- name: ListMatchDetailsByCompetitionID :many
 -- Retrieve all match details for a specific competition ID.
 -- This query performs a JOIN between match_details and fixtures to get all 
--- match details that are part of a specific competition.
+-- match details that are part of a specific competition and season. If no
+-- season is given, the season of the competition's current round is used, or its
+-- latest season if it has no current round.
 SELECT 
   sqlc.embed(md), 
   sqlc.embed(f), 
   sqlc.embed(home_team), 
-  sqlc.embed(away_team)
+  sqlc.embed(away_team),
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
-WHERE f.competition_id = $1
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
+WHERE 
+  f.competition_id = $1
+  AND f.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime;
 
 -- name: ListRoundMatchDetailsByCompetitionID :many
 -- Retrieve all match details for a specific competition ID.
 -- This query performs a JOIN between match_details and fixtures to get all 
--- match details that are part of a specific competition and round.
+-- match details that are part of a specific competition, season and round. If
+-- no season is given, the season of the competition's current round is used, or
+-- its latest season if it has no current round.
 SELECT 
   sqlc.embed(md), 
   sqlc.embed(f), 
   sqlc.embed(home_team), 
-  sqlc.embed(away_team)
+  sqlc.embed(away_team),
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
 WHERE 
   f.competition_id = $1
-  AND f.roundTitle = $2
+  AND f.round_number = $2
+  AND f.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime;
 
 -- name: ListCurrentRoundMatchDetailsByCompetitionID :many
 -- Retrieve all match details for a specific competition ID.
 -- This query performs a JOIN between match_details and fixtures to get all 
--- match details that are part of a specific competition and round.
+-- match details that are part of the current round of a competition season.
+-- If no season is given, the season of the competition's current round is used,
+-- or its latest season if it has no current round.
 SELECT 
   sqlc.embed(md), 
   sqlc.embed(f), 
   sqlc.embed(home_team), 
-  sqlc.embed(away_team)
+  sqlc.embed(away_team),
+  COALESCE(r.points_weight, 1)::INTEGER AS points_weight,
+  v.timezone AS venue_timezone
 FROM match_details md
 JOIN fixtures f ON md.fixture_id = f.id
 JOIN teams home_team ON md.homeTeam_id = home_team.id
 JOIN teams away_team ON md.awayTeam_id = away_team.id
-JOIN competitions c ON f.competition_id = c.id
+LEFT JOIN rounds r ON f.competition_id = r.competition_id AND f.season = r.season AND f.round_number = r.number
+LEFT JOIN venues v ON f.venue_id = v.id
+JOIN competition_seasons cs ON f.competition_id = cs.competition_id AND f.season = cs.season
 WHERE 
-  c.id = $1
-  AND f.roundTitle = c.round
+  cs.competition_id = $1
+  AND f.roundTitle = cs.round
+  AND f.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
 ORDER BY f.kickOffTime;
 
+-- name: ListTeamFormResults :many
+-- Retrieve the last completed matches of each of a set of teams in a competition
+-- before a time, newest first, used to calculate the recent form of teams. Each
+-- team is given with the kickoff time of the fixture its form is needed for.
+SELECT 
+  t.competition_id::BIGINT AS competition_id,
+  t.team_id::BIGINT AS team_id,
+  t.kick_off_before::TIMESTAMPTZ AS kick_off_before,
+  r.fixture_id,
+  r.kickOffTime,
+  r.homeTeam_id,
+  r.awayTeam_id,
+  r.homeTeam_nickname,
+  r.awayTeam_nickname,
+  r.homeTeam_score,
+  r.awayTeam_score
+FROM (
+  SELECT 
+    UNNEST(sqlc.arg('competition_ids')::BIGINT[]) AS competition_id,
+    UNNEST(sqlc.arg('team_ids')::BIGINT[]) AS team_id,
+    UNNEST(sqlc.arg('kick_offs_before')::TIMESTAMPTZ[]) AS kick_off_before
+) t
+CROSS JOIN LATERAL (
+  SELECT 
+    md.fixture_id,
+    f.kickOffTime,
+    md.homeTeam_id,
+    md.awayTeam_id,
+    home_team.nickname AS homeTeam_nickname,
+    away_team.nickname AS awayTeam_nickname,
+    md.homeTeam_score,
+    md.awayTeam_score
+  FROM match_details md
+  JOIN fixtures f ON md.fixture_id = f.id
+  JOIN teams home_team ON md.homeTeam_id = home_team.id
+  JOIN teams away_team ON md.awayTeam_id = away_team.id
+  WHERE 
+    f.competition_id = t.competition_id
+    AND (md.homeTeam_id = t.team_id OR md.awayTeam_id = t.team_id)
+    AND f.kickOffTime < t.kick_off_before
+    AND f.matchState = 'FullTime'
+    AND md.homeTeam_score IS NOT NULL
+    AND md.awayTeam_score IS NOT NULL
+  ORDER BY f.kickOffTime DESC
+  LIMIT sqlc.arg('matches')::INTEGER
+) r
+ORDER BY t.competition_id, t.team_id, t.kick_off_before, r.kickOffTime DESC;
+
+-- name: ListHeadToHeadResults :many
+-- Retrieve every completed match between two teams, newest first, regardless of
+-- which of them was the home team.
+SELECT 
+  md.fixture_id,
+  f.competition_id,
+  f.season,
+  f.roundTitle,
+  f.venue,
+  f.venueCity,
+  f.venue_id,
+  f.kickOffTime,
+  md.homeTeam_id,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+WHERE 
+  (
+    (md.homeTeam_id = sqlc.arg('team_id') AND md.awayTeam_id = sqlc.arg('opponent_id'))
+    OR (md.homeTeam_id = sqlc.arg('opponent_id') AND md.awayTeam_id = sqlc.arg('team_id'))
+  )
+  AND f.matchState = 'FullTime'
+  AND md.homeTeam_score IS NOT NULL
+  AND md.awayTeam_score IS NOT NULL
+ORDER BY f.kickOffTime DESC;
+
+-- name: GetNextHeadToHeadFixture :one
+-- Retrieve the next fixture between two teams that kicks off after a time.
+SELECT f.*
+FROM match_details md
+JOIN fixtures f ON md.fixture_id = f.id
+WHERE 
+  (
+    (md.homeTeam_id = sqlc.arg('team_id') AND md.awayTeam_id = sqlc.arg('opponent_id'))
+    OR (md.homeTeam_id = sqlc.arg('opponent_id') AND md.awayTeam_id = sqlc.arg('team_id'))
+  )
+  AND f.kickOffTime > sqlc.arg('after')
+ORDER BY f.kickOffTime
+LIMIT 1;
+
 -- name: CreateMatchDetail :one
 -- Insert a new match detail record into the match_details table.
 -- If a match detail with the same fixture_id already exists, do nothing.
@@ -92,13 +230,43 @@ RETURNING *;
 
 -- name: UpdateMatchDetail :one
 -- Conditionally update match detail fields based on provided arguments.
--- Only updates fields where the argument is not NULL.
+-- Only updates fields where the argument is not NULL, except the winner, which
+-- is set whenever both scores are given so that a draw clears a previous winner.
 UPDATE match_details 
 SET 
     homeTeam_odds = COALESCE(sqlc.narg('homeTeam_odds'), homeTeam_odds), 
     awayTeam_odds = COALESCE(sqlc.narg('awayTeam_odds'), awayTeam_odds), 
     homeTeam_score = COALESCE(sqlc.narg('homeTeam_score'), homeTeam_score), 
     awayTeam_score = COALESCE(sqlc.narg('awayTeam_score'), awayTeam_score), 
-    winner_teamId = COALESCE(sqlc.narg('winner_teamId'), winner_teamId)
+    winner_teamId = CASE
+        WHEN sqlc.narg('homeTeam_score')::INTEGER IS NOT NULL AND sqlc.narg('awayTeam_score')::INTEGER IS NOT NULL
+        THEN sqlc.narg('winner_teamId')
+        ELSE winner_teamId
+    END
 WHERE fixture_id = $1
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: ListSeriesFixturesByCompetitionID :many
+-- Retrieve every game of a series competition (e.g. State of Origin) up to and
+-- including a season, oldest first, with the scores of the games played. If no
+-- season is given, every season is included.
+SELECT 
+  f.id AS fixture_id,
+  f.season,
+  f.roundTitle,
+  f.matchState,
+  f.kickOffTime,
+  md.homeTeam_id,
+  md.awayTeam_id,
+  home_team.nickname AS homeTeam_nickname,
+  away_team.nickname AS awayTeam_nickname,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM fixtures f
+JOIN match_details md ON md.fixture_id = f.id
+JOIN teams home_team ON md.homeTeam_id = home_team.id
+JOIN teams away_team ON md.awayTeam_id = away_team.id
+WHERE 
+  f.competition_id = $1
+  AND f.season <= COALESCE(sqlc.narg('season')::INTEGER, f.season)
+ORDER BY f.season, f.kickOffTime;
@@ -0,0 +1,38 @@
+-- name: ListMatchEventsByFixtureID :many
+-- Retrieve the timeline of a fixture in order, with the name of the player
+-- involved in each event if they are known.
+SELECT 
+  sqlc.embed(me), 
+  p.first_name, 
+  p.last_name
+FROM match_events me
+LEFT JOIN players p ON me.player_id = p.id
+WHERE me.fixture_id = $1
+ORDER BY me.sequence;
+
+-- name: DeleteMatchEvents :exec
+-- Remove the timeline of a fixture, before storing the latest timeline.
+DELETE FROM match_events
+WHERE fixture_id = $1;
+
+-- name: CreateMatchEvent :exec
+-- Insert an event into the timeline of a fixture.
+INSERT INTO match_events (
+  fixture_id, sequence, type, title, game_seconds, team_id, player_id, 
+  homeTeam_score, awayTeam_score
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: ListMatchTeamStatsByFixtureID :many
+-- Retrieve the statistics of both teams of a fixture.
+SELECT * FROM match_team_stats
+WHERE fixture_id = $1
+ORDER BY category, name, team_id;
+
+-- name: UpsertMatchTeamStat :exec
+-- Insert a team's statistic for a fixture, or update its value if it already exists.
+INSERT INTO match_team_stats (fixture_id, team_id, category, name, value)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (fixture_id, team_id, category, name) DO UPDATE
+SET value = EXCLUDED.value;
@@ -0,0 +1,35 @@
+-- The nrl_response_cache table stores the last response for each document
+-- fetched from the NRL API, so unchanged documents can be detected with
+-- conditional requests and content hashes.
+
+-- name: GetNRLResponseCache :one
+-- Retrieve the cached response for a specific NRL API URL.
+SELECT * FROM nrl_response_cache WHERE url = $1;
+
+-- name: UpsertNRLResponseCache :one
+-- Insert or replace the cached response for an NRL API URL.
+INSERT INTO nrl_response_cache (
+  url, etag, last_modified, content_hash, body, fetched_at
+) VALUES (
+  $1, $2, $3, $4, $5, NOW()
+)
+ON CONFLICT (url) DO UPDATE
+SET 
+  etag = EXCLUDED.etag,
+  last_modified = EXCLUDED.last_modified,
+  content_hash = EXCLUDED.content_hash,
+  body = EXCLUDED.body,
+  fetched_at = EXCLUDED.fetched_at
+RETURNING *;
+
+-- name: TouchNRLResponseCache :exec
+-- Mark a cached response as fetched again without changing its content.
+UPDATE nrl_response_cache
+SET fetched_at = NOW()
+WHERE url = $1;
+
+-- name: DeleteNRLResponseCache :exec
+-- Remove the cached response for an NRL API URL so the next fetch treats the
+-- document as changed.
+DELETE FROM nrl_response_cache
+WHERE url = $1;
@@ -0,0 +1,23 @@
+-- name: GetLatestOddsByFixtureID :one
+-- Retrieve the most recently recorded odds of a fixture.
+SELECT * FROM odds_history
+WHERE fixture_id = $1
+ORDER BY recorded_at DESC, id DESC
+LIMIT 1;
+
+-- name: CreateOddsHistory :one
+-- Record the odds of a fixture as at now.
+INSERT INTO odds_history (
+  fixture_id, homeTeam_odds, awayTeam_odds
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: ListOpeningOddsByFixtureIDs :many
+-- Retrieve the first recorded odds of each of a set of fixtures.
+SELECT DISTINCT ON (fixture_id) * 
+FROM odds_history
+WHERE fixture_id = ANY(sqlc.arg('fixture_ids')::BIGINT[])
+ORDER BY fixture_id, recorded_at, id;
+
@@ -0,0 +1,103 @@
+-- name: ListRatingFixturesByCompetitionID :many
+-- Retrieve every fixture of a competition with its teams and scores, in the
+-- order they kicked off, used to replay the results into team ratings.
+SELECT 
+  f.id,
+  f.season,
+  f.venue_id,
+  f.matchState,
+  md.homeTeam_id,
+  md.awayTeam_id,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM fixtures f
+JOIN match_details md ON md.fixture_id = f.id
+WHERE f.competition_id = $1
+ORDER BY f.kickOffTime, f.id;
+
+-- name: UpsertTeamRatings :exec
+-- Insert the ratings of a set of teams in a competition, or update those that
+-- already exist and have changed.
+INSERT INTO team_ratings (
+  competition_id, team_id, rating, matches, season
+)
+SELECT 
+  sqlc.arg('competition_id')::BIGINT,
+  UNNEST(sqlc.arg('team_ids')::BIGINT[]),
+  UNNEST(sqlc.arg('ratings')::DOUBLE PRECISION[]),
+  UNNEST(sqlc.arg('matches')::INTEGER[]),
+  UNNEST(sqlc.arg('seasons')::INTEGER[])
+ON CONFLICT (competition_id, team_id) DO UPDATE
+SET 
+  rating = EXCLUDED.rating,
+  matches = EXCLUDED.matches,
+  season = EXCLUDED.season,
+  updated_at = NOW()
+WHERE 
+  (team_ratings.rating, team_ratings.matches, team_ratings.season)
+  IS DISTINCT FROM (EXCLUDED.rating, EXCLUDED.matches, EXCLUDED.season);
+
+-- name: ListTeamRatingsByCompetitionID :many
+-- Retrieve the ratings of the teams in a competition, highest rated first.
+SELECT 
+  sqlc.embed(tr), 
+  sqlc.embed(t)
+FROM team_ratings tr
+JOIN teams t ON tr.team_id = t.id
+WHERE tr.competition_id = $1
+ORDER BY tr.rating DESC;
+
+-- name: UpsertFixturePredictions :exec
+-- Insert the predictions of a set of fixtures, or update those that already
+-- exist and have changed.
+INSERT INTO fixture_predictions (
+  fixture_id, home_rating, away_rating, home_win_probability, predicted_margin
+)
+SELECT 
+  UNNEST(sqlc.arg('fixture_ids')::BIGINT[]),
+  UNNEST(sqlc.arg('home_ratings')::DOUBLE PRECISION[]),
+  UNNEST(sqlc.arg('away_ratings')::DOUBLE PRECISION[]),
+  UNNEST(sqlc.arg('home_win_probabilities')::DOUBLE PRECISION[]),
+  UNNEST(sqlc.arg('predicted_margins')::DOUBLE PRECISION[])
+ON CONFLICT (fixture_id) DO UPDATE
+SET 
+  home_rating = EXCLUDED.home_rating,
+  away_rating = EXCLUDED.away_rating,
+  home_win_probability = EXCLUDED.home_win_probability,
+  predicted_margin = EXCLUDED.predicted_margin,
+  updated_at = NOW()
+WHERE 
+  (fixture_predictions.home_rating, fixture_predictions.away_rating, fixture_predictions.home_win_probability, fixture_predictions.predicted_margin)
+  IS DISTINCT FROM (EXCLUDED.home_rating, EXCLUDED.away_rating, EXCLUDED.home_win_probability, EXCLUDED.predicted_margin);
+
+-- name: ListFixturePredictionsByFixtureIDs :many
+-- Retrieve the predictions of a set of fixtures.
+SELECT * FROM fixture_predictions
+WHERE fixture_id = ANY(sqlc.arg('fixture_ids')::BIGINT[]);
+
+-- name: ListPredictionResultsByCompetitionID :many
+-- Retrieve the prediction, bookmaker odds and scores of every completed match of
+-- a competition season, used to compare the calibration of the predictions. If
+-- no season is given, the season of the competition's current round is used, or
+-- its latest season if it has no current round.
+SELECT 
+  fp.fixture_id,
+  fp.home_win_probability,
+  md.homeTeam_odds,
+  md.awayTeam_odds,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM fixture_predictions fp
+JOIN fixtures f ON fp.fixture_id = f.id
+JOIN match_details md ON md.fixture_id = f.id
+WHERE 
+  f.competition_id = $1
+  AND f.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
+  AND f.matchState = 'FullTime'
+  AND md.homeTeam_score IS NOT NULL
+  AND md.awayTeam_score IS NOT NULL
+ORDER BY f.kickOffTime;
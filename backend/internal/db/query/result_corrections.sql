@@ -0,0 +1,14 @@
+-- name: CreateResultCorrection :one
+-- Record a change to the final score of a completed fixture.
+INSERT INTO result_corrections (
+  fixture_id, previous_homeTeam_score, previous_awayTeam_score, homeTeam_score, awayTeam_score
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListResultCorrectionsByFixtureID :many
+-- Retrieve the corrections to the result of a fixture, oldest first.
+SELECT * FROM result_corrections
+WHERE fixture_id = $1
+ORDER BY detected_at, id;
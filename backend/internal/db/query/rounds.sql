@@ -0,0 +1,119 @@
+-- name: ListRoundsByCompetitionID :many
+-- Retrieve all rounds of a competition season, ordered by round number.
+-- If no season is given, the season of the competition's current round is used,
+-- or its latest season if it has no current round.
+SELECT * FROM rounds r
+WHERE 
+  r.competition_id = $1
+  AND r.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = r.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM rounds WHERE competition_id = r.competition_id)
+  )
+ORDER BY r.number;
+
+-- name: ListRoundByesByCompetitionID :many
+-- Retrieve the teams on the bye for every round of a competition season.
+-- If no season is given, the season of the competition's current round is used,
+-- or its latest season if it has no current round.
+SELECT 
+  rb.round_number,
+  sqlc.embed(t)
+FROM round_byes rb
+JOIN teams t ON rb.team_id = t.id
+WHERE 
+  rb.competition_id = $1
+  AND rb.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = rb.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM rounds WHERE competition_id = rb.competition_id)
+  )
+ORDER BY rb.round_number, t.nickName;
+
+-- name: GetRoundBySlug :one
+-- Retrieve a round of a competition season by its slug (e.g., grand-final).
+-- If no season is given, the season of the competition's current round is used,
+-- or its latest season if it has no current round.
+SELECT * FROM rounds r
+WHERE 
+  r.competition_id = $1
+  AND r.slug = $2
+  AND r.season = COALESCE(
+    sqlc.narg('season')::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = r.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM rounds WHERE competition_id = r.competition_id)
+  )
+ORDER BY r.number
+LIMIT 1;
+
+-- name: GetNextFinalsWeek :one
+-- Retrieve the finals week that follows the finals rounds played before the
+-- given round number, or 1 if no finals rounds have been played yet.
+SELECT (COALESCE(MAX(finals_week), 0) + 1)::INTEGER AS finals_week
+FROM rounds
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND number < $3
+  AND finals_week IS NOT NULL;
+
+-- name: UpsertRound :one
+-- Insert a round, or update the title, type and finals metadata of an existing round.
+INSERT INTO rounds (competition_id, season, number, title, slug, type, finals_week, points_weight)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (competition_id, season, number) DO UPDATE
+SET 
+  title = EXCLUDED.title,
+  slug = EXCLUDED.slug,
+  type = EXCLUDED.type,
+  finals_week = EXCLUDED.finals_week,
+  points_weight = EXCLUDED.points_weight
+RETURNING *;
+
+-- name: RefreshRoundKickOffs :one
+-- Recalculate the first and last kickoff times of a round from its fixtures.
+UPDATE rounds r
+SET 
+  first_kickoff = k.first_kickoff,
+  last_kickoff = k.last_kickoff
+FROM (
+  SELECT 
+    MIN(f.kickOffTime)::TIMESTAMPTZ AS first_kickoff, 
+    MAX(f.kickOffTime)::TIMESTAMPTZ AS last_kickoff
+  FROM fixtures f
+  WHERE 
+    f.competition_id = $1
+    AND f.season = $2
+    AND f.round_number = $3
+) k
+WHERE 
+  r.competition_id = $1
+  AND r.season = $2
+  AND r.number = $3
+RETURNING r.*;
+
+-- name: DeleteRoundByes :exec
+-- Remove all teams on the bye for a round, before storing the latest byes.
+DELETE FROM round_byes
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND round_number = $3;
+
+-- name: CreateRoundBye :exec
+-- Record a team as being on the bye for a round.
+-- If the team is already recorded for the round, do nothing.
+INSERT INTO round_byes (competition_id, season, round_number, team_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING;
+
+-- name: ListAllRounds :many
+-- Retrieve every round of every competition season.
+SELECT * FROM rounds
+ORDER BY competition_id, season, number;
+
+-- name: UpdateRoundPointsWeight :exec
+-- Set the multiplier applied to the points of tips on fixtures in a round.
+UPDATE rounds
+SET points_weight = $4
+WHERE competition_id = $1 AND season = $2 AND number = $3;
@@ -0,0 +1,59 @@
+-- name: UpsertPlayer :one
+-- Insert a player, or update the name of an existing player.
+INSERT INTO players (id, first_name, last_name)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE
+SET 
+  first_name = EXCLUDED.first_name,
+  last_name = EXCLUDED.last_name
+RETURNING *;
+
+-- name: ListTeamListsByFixtureID :many
+-- Retrieve the players named by both teams of a fixture, ordered by team and jersey number.
+SELECT 
+  sqlc.embed(tl), 
+  sqlc.embed(p)
+FROM team_lists tl
+JOIN players p ON tl.player_id = p.id
+WHERE tl.fixture_id = $1
+ORDER BY tl.team_id, tl.number;
+
+-- name: CreateTeamListPlayer :exec
+-- Name a player in a team's list for a fixture.
+INSERT INTO team_lists (fixture_id, team_id, player_id, number, position)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: UpdateTeamListPlayer :exec
+-- Update the jersey number and position a player is named in for a fixture.
+UPDATE team_lists
+SET 
+  number = $4,
+  position = $5
+WHERE 
+  fixture_id = $1
+  AND team_id = $2
+  AND player_id = $3;
+
+-- name: DeleteTeamListPlayer :exec
+-- Remove a player from a team's list for a fixture.
+DELETE FROM team_lists
+WHERE 
+  fixture_id = $1
+  AND team_id = $2
+  AND player_id = $3;
+
+-- name: CreateTeamListChange :exec
+-- Record a change to a team's list after it was first announced.
+INSERT INTO team_list_changes (fixture_id, team_id, player_id, change, number, position)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListTeamListChangesByFixtureID :many
+-- Retrieve the changes to both team lists of a fixture, in the order they were detected.
+SELECT 
+  sqlc.embed(tlc), 
+  sqlc.embed(p)
+FROM team_list_changes tlc
+JOIN players p ON tlc.player_id = p.id
+WHERE tlc.fixture_id = $1
+ORDER BY tlc.detected_at, tlc.id;
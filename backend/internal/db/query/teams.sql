@@ -2,14 +2,78 @@
 -- Retrieve all teams available in the system.
 SELECT * FROM teams;
 
+-- name: ListTeamsByCompetitionID :many
+-- Retrieve all teams that have played in a competition in any season.
+SELECT * FROM teams t
+WHERE EXISTS (
+  SELECT 1 FROM team_competitions tc 
+  WHERE tc.team_id = t.id AND tc.competition_id = $1
+)
+ORDER BY t.nickName;
+
 -- name: GetTeamByID :one
 -- Retrieve a specific team by its unique identifier.
 SELECT * FROM teams WHERE id = $1;
 
+-- name: GetTeamByNickname :one
+-- Retrieve a team of a competition by its nickname (e.g., Cowboys).
+SELECT t.* FROM teams t
+WHERE 
+  t.nickName = $1
+  AND EXISTS (
+    SELECT 1 FROM team_competitions tc 
+    WHERE tc.team_id = t.id AND tc.competition_id = $2
+  );
+
 -- name: CreateTeam :one
 -- Insert a new team into the teams table.
--- If a team with the same id already exists, do nothing.
-INSERT INTO teams (id, nickName, competition_id) 
-VALUES ($1, $2, $3)
+INSERT INTO teams (id, nickName) 
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UpsertTeam :one
+-- Insert a team, or update the details of an existing team. Details that are
+-- not given keep their existing value, so a team can be stored from a source
+-- which only has its nickname.
+INSERT INTO teams (id, nickName, name, code, primary_colour, secondary_colour, logo_url)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE
+SET 
+  nickName = EXCLUDED.nickName,
+  name = COALESCE(EXCLUDED.name, teams.name),
+  code = COALESCE(EXCLUDED.code, teams.code),
+  primary_colour = COALESCE(EXCLUDED.primary_colour, teams.primary_colour),
+  secondary_colour = COALESCE(EXCLUDED.secondary_colour, teams.secondary_colour),
+  logo_url = COALESCE(EXCLUDED.logo_url, teams.logo_url)
 RETURNING *;
 
+-- name: AddTeamCompetition :exec
+-- Record that a team played in a competition season.
+-- If it has already been recorded, do nothing.
+INSERT INTO team_competitions (team_id, competition_id, season)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: ListTeamCompetitions :many
+-- Retrieve every competition season of every team, ordered by team, competition and season.
+SELECT * FROM team_competitions
+ORDER BY team_id, competition_id, season;
+
+-- name: ListTeamCompetitionsByTeamID :many
+-- Retrieve the competition seasons a team has played in, ordered by competition and season.
+SELECT * FROM team_competitions
+WHERE team_id = $1
+ORDER BY competition_id, season;
+
+-- name: ListTeamHomeGrounds :many
+-- Retrieve the venues a team has played home matches at, with the number of
+-- home matches played at each, most used first.
+SELECT 
+  f.venue, 
+  f.venueCity, 
+  COUNT(*)::INTEGER AS matches
+FROM fixtures f
+JOIN match_details md ON md.fixture_id = f.id
+WHERE md.homeTeam_id = $1
+GROUP BY f.venue, f.venueCity
+ORDER BY matches DESC, f.venue;
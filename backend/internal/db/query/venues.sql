@@ -0,0 +1,35 @@
+-- name: ListVenues :many
+-- Retrieve all venues ordered by country, city and name.
+SELECT * FROM venues
+ORDER BY country, city, name;
+
+-- name: GetVenueByID :one
+-- Retrieve a specific venue by its unique identifier.
+SELECT * FROM venues WHERE id = $1;
+
+-- name: UpsertVenue :one
+-- Insert a venue, or update the location of an existing venue, and return it.
+INSERT INTO venues (
+  name, city, state, country, timezone
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (name, city) DO UPDATE
+SET 
+  state = EXCLUDED.state,
+  country = EXCLUDED.country,
+  timezone = EXCLUDED.timezone
+RETURNING *;
+
+-- name: ListUnlinkedFixtureVenues :many
+-- Retrieve the distinct venues of fixtures which are not linked to a venue.
+SELECT DISTINCT venue, venueCity
+FROM fixtures
+WHERE venue_id IS NULL
+ORDER BY venueCity, venue;
+
+-- name: LinkFixturesToVenue :execrows
+-- Link the fixtures played at a venue, which are not linked yet, to the venue.
+UPDATE fixtures
+SET venue_id = sqlc.arg('venue_id')
+WHERE venue = sqlc.arg('venue') AND venueCity = sqlc.arg('venue_city') AND venue_id IS NULL;
@@ -0,0 +1,305 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: ratings.sql
+
+package db
+
+import (
+	"context"
+)
+
+const listFixturePredictionsByFixtureIDs = `-- name: ListFixturePredictionsByFixtureIDs :many
+SELECT fixture_id, home_rating, away_rating, home_win_probability, predicted_margin, updated_at FROM fixture_predictions
+WHERE fixture_id = ANY($1::BIGINT[])
+`
+
+// Retrieve the predictions of a set of fixtures.
+func (q *Queries) ListFixturePredictionsByFixtureIDs(ctx context.Context, fixtureIds []int64) ([]*FixturePrediction, error) {
+	rows, err := q.db.Query(ctx, listFixturePredictionsByFixtureIDs, fixtureIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*FixturePrediction
+	for rows.Next() {
+		var i FixturePrediction
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.HomeRating,
+			&i.AwayRating,
+			&i.HomeWinProbability,
+			&i.PredictedMargin,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPredictionResultsByCompetitionID = `-- name: ListPredictionResultsByCompetitionID :many
+SELECT 
+  fp.fixture_id,
+  fp.home_win_probability,
+  md.homeTeam_odds,
+  md.awayTeam_odds,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM fixture_predictions fp
+JOIN fixtures f ON fp.fixture_id = f.id
+JOIN match_details md ON md.fixture_id = f.id
+WHERE 
+  f.competition_id = $1
+  AND f.season = COALESCE(
+    $2::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = f.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM fixtures WHERE competition_id = f.competition_id)
+  )
+  AND f.matchState = 'FullTime'
+  AND md.homeTeam_score IS NOT NULL
+  AND md.awayTeam_score IS NOT NULL
+ORDER BY f.kickOffTime
+`
+
+type ListPredictionResultsByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+}
+
+type ListPredictionResultsByCompetitionIDRow struct {
+	FixtureID          int64
+	HomeWinProbability float64
+	HometeamOdds       *float64
+	AwayteamOdds       *float64
+	HometeamScore      *int32
+	AwayteamScore      *int32
+}
+
+// Retrieve the prediction, bookmaker odds and scores of every completed match of
+// a competition season, used to compare the calibration of the predictions. If
+// no season is given, the season of the competition's current round is used, or
+// its latest season if it has no current round.
+func (q *Queries) ListPredictionResultsByCompetitionID(ctx context.Context, arg ListPredictionResultsByCompetitionIDParams) ([]*ListPredictionResultsByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listPredictionResultsByCompetitionID, arg.CompetitionID, arg.Season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListPredictionResultsByCompetitionIDRow
+	for rows.Next() {
+		var i ListPredictionResultsByCompetitionIDRow
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.HomeWinProbability,
+			&i.HometeamOdds,
+			&i.AwayteamOdds,
+			&i.HometeamScore,
+			&i.AwayteamScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRatingFixturesByCompetitionID = `-- name: ListRatingFixturesByCompetitionID :many
+SELECT 
+  f.id,
+  f.season,
+  f.venue_id,
+  f.matchState,
+  md.homeTeam_id,
+  md.awayTeam_id,
+  md.homeTeam_score,
+  md.awayTeam_score
+FROM fixtures f
+JOIN match_details md ON md.fixture_id = f.id
+WHERE f.competition_id = $1
+ORDER BY f.kickOffTime, f.id
+`
+
+type ListRatingFixturesByCompetitionIDRow struct {
+	ID            int64
+	Season        int32
+	VenueID       *int32
+	Matchstate    string
+	HometeamID    int64
+	AwayteamID    int64
+	HometeamScore *int32
+	AwayteamScore *int32
+}
+
+// Retrieve every fixture of a competition with its teams and scores, in the
+// order they kicked off, used to replay the results into team ratings.
+func (q *Queries) ListRatingFixturesByCompetitionID(ctx context.Context, competitionID int64) ([]*ListRatingFixturesByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listRatingFixturesByCompetitionID, competitionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListRatingFixturesByCompetitionIDRow
+	for rows.Next() {
+		var i ListRatingFixturesByCompetitionIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Season,
+			&i.VenueID,
+			&i.Matchstate,
+			&i.HometeamID,
+			&i.AwayteamID,
+			&i.HometeamScore,
+			&i.AwayteamScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamRatingsByCompetitionID = `-- name: ListTeamRatingsByCompetitionID :many
+SELECT 
+  tr.competition_id, tr.team_id, tr.rating, tr.matches, tr.season, tr.updated_at, 
+  t.id, t.nickname, t.name, t.code, t.primary_colour, t.secondary_colour, t.logo_url
+FROM team_ratings tr
+JOIN teams t ON tr.team_id = t.id
+WHERE tr.competition_id = $1
+ORDER BY tr.rating DESC
+`
+
+type ListTeamRatingsByCompetitionIDRow struct {
+	TeamRating TeamRating
+	Team       Team
+}
+
+// Retrieve the ratings of the teams in a competition, highest rated first.
+func (q *Queries) ListTeamRatingsByCompetitionID(ctx context.Context, competitionID int64) ([]*ListTeamRatingsByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listTeamRatingsByCompetitionID, competitionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTeamRatingsByCompetitionIDRow
+	for rows.Next() {
+		var i ListTeamRatingsByCompetitionIDRow
+		if err := rows.Scan(
+			&i.TeamRating.CompetitionID,
+			&i.TeamRating.TeamID,
+			&i.TeamRating.Rating,
+			&i.TeamRating.Matches,
+			&i.TeamRating.Season,
+			&i.TeamRating.UpdatedAt,
+			&i.Team.ID,
+			&i.Team.Nickname,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFixturePredictions = `-- name: UpsertFixturePredictions :exec
+INSERT INTO fixture_predictions (
+  fixture_id, home_rating, away_rating, home_win_probability, predicted_margin
+)
+SELECT 
+  UNNEST($1::BIGINT[]),
+  UNNEST($2::DOUBLE PRECISION[]),
+  UNNEST($3::DOUBLE PRECISION[]),
+  UNNEST($4::DOUBLE PRECISION[]),
+  UNNEST($5::DOUBLE PRECISION[])
+ON CONFLICT (fixture_id) DO UPDATE
+SET 
+  home_rating = EXCLUDED.home_rating,
+  away_rating = EXCLUDED.away_rating,
+  home_win_probability = EXCLUDED.home_win_probability,
+  predicted_margin = EXCLUDED.predicted_margin,
+  updated_at = NOW()
+WHERE 
+  (fixture_predictions.home_rating, fixture_predictions.away_rating, fixture_predictions.home_win_probability, fixture_predictions.predicted_margin)
+  IS DISTINCT FROM (EXCLUDED.home_rating, EXCLUDED.away_rating, EXCLUDED.home_win_probability, EXCLUDED.predicted_margin)
+`
+
+type UpsertFixturePredictionsParams struct {
+	FixtureIds           []int64
+	HomeRatings          []float64
+	AwayRatings          []float64
+	HomeWinProbabilities []float64
+	PredictedMargins     []float64
+}
+
+// Insert the predictions of a set of fixtures, or update those that already
+// exist and have changed.
+func (q *Queries) UpsertFixturePredictions(ctx context.Context, arg UpsertFixturePredictionsParams) error {
+	_, err := q.db.Exec(ctx, upsertFixturePredictions,
+		arg.FixtureIds,
+		arg.HomeRatings,
+		arg.AwayRatings,
+		arg.HomeWinProbabilities,
+		arg.PredictedMargins,
+	)
+	return err
+}
+
+const upsertTeamRatings = `-- name: UpsertTeamRatings :exec
+INSERT INTO team_ratings (
+  competition_id, team_id, rating, matches, season
+)
+SELECT 
+  $1::BIGINT,
+  UNNEST($2::BIGINT[]),
+  UNNEST($3::DOUBLE PRECISION[]),
+  UNNEST($4::INTEGER[]),
+  UNNEST($5::INTEGER[])
+ON CONFLICT (competition_id, team_id) DO UPDATE
+SET 
+  rating = EXCLUDED.rating,
+  matches = EXCLUDED.matches,
+  season = EXCLUDED.season,
+  updated_at = NOW()
+WHERE 
+  (team_ratings.rating, team_ratings.matches, team_ratings.season)
+  IS DISTINCT FROM (EXCLUDED.rating, EXCLUDED.matches, EXCLUDED.season)
+`
+
+type UpsertTeamRatingsParams struct {
+	CompetitionID int64
+	TeamIds       []int64
+	Ratings       []float64
+	Matches       []int32
+	Seasons       []int32
+}
+
+// Insert the ratings of a set of teams in a competition, or update those that
+// already exist and have changed.
+func (q *Queries) UpsertTeamRatings(ctx context.Context, arg UpsertTeamRatingsParams) error {
+	_, err := q.db.Exec(ctx, upsertTeamRatings,
+		arg.CompetitionID,
+		arg.TeamIds,
+		arg.Ratings,
+		arg.Matches,
+		arg.Seasons,
+	)
+	return err
+}
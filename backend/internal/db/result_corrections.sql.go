@@ -0,0 +1,84 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: result_corrections.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createResultCorrection = `-- name: CreateResultCorrection :one
+INSERT INTO result_corrections (
+  fixture_id, previous_homeTeam_score, previous_awayTeam_score, homeTeam_score, awayTeam_score
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, fixture_id, previous_hometeam_score, previous_awayteam_score, hometeam_score, awayteam_score, detected_at
+`
+
+type CreateResultCorrectionParams struct {
+	FixtureID             int64
+	PreviousHometeamScore int32
+	PreviousAwayteamScore int32
+	HometeamScore         int32
+	AwayteamScore         int32
+}
+
+// Record a change to the final score of a completed fixture.
+func (q *Queries) CreateResultCorrection(ctx context.Context, arg CreateResultCorrectionParams) (*ResultCorrection, error) {
+	row := q.db.QueryRow(ctx, createResultCorrection,
+		arg.FixtureID,
+		arg.PreviousHometeamScore,
+		arg.PreviousAwayteamScore,
+		arg.HometeamScore,
+		arg.AwayteamScore,
+	)
+	var i ResultCorrection
+	err := row.Scan(
+		&i.ID,
+		&i.FixtureID,
+		&i.PreviousHometeamScore,
+		&i.PreviousAwayteamScore,
+		&i.HometeamScore,
+		&i.AwayteamScore,
+		&i.DetectedAt,
+	)
+	return &i, err
+}
+
+const listResultCorrectionsByFixtureID = `-- name: ListResultCorrectionsByFixtureID :many
+SELECT id, fixture_id, previous_hometeam_score, previous_awayteam_score, hometeam_score, awayteam_score, detected_at FROM result_corrections
+WHERE fixture_id = $1
+ORDER BY detected_at, id
+`
+
+// Retrieve the corrections to the result of a fixture, oldest first.
+func (q *Queries) ListResultCorrectionsByFixtureID(ctx context.Context, fixtureID int64) ([]*ResultCorrection, error) {
+	rows, err := q.db.Query(ctx, listResultCorrectionsByFixtureID, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ResultCorrection
+	for rows.Next() {
+		var i ResultCorrection
+		if err := rows.Scan(
+			&i.ID,
+			&i.FixtureID,
+			&i.PreviousHometeamScore,
+			&i.PreviousAwayteamScore,
+			&i.HometeamScore,
+			&i.AwayteamScore,
+			&i.DetectedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,390 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: rounds.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createRoundBye = `-- name: CreateRoundBye :exec
+INSERT INTO round_byes (competition_id, season, round_number, team_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+`
+
+type CreateRoundByeParams struct {
+	CompetitionID int64
+	Season        int32
+	RoundNumber   int32
+	TeamID        int64
+}
+
+// Record a team as being on the bye for a round.
+// If the team is already recorded for the round, do nothing.
+func (q *Queries) CreateRoundBye(ctx context.Context, arg CreateRoundByeParams) error {
+	_, err := q.db.Exec(ctx, createRoundBye,
+		arg.CompetitionID,
+		arg.Season,
+		arg.RoundNumber,
+		arg.TeamID,
+	)
+	return err
+}
+
+const deleteRoundByes = `-- name: DeleteRoundByes :exec
+DELETE FROM round_byes
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND round_number = $3
+`
+
+type DeleteRoundByesParams struct {
+	CompetitionID int64
+	Season        int32
+	RoundNumber   int32
+}
+
+// Remove all teams on the bye for a round, before storing the latest byes.
+func (q *Queries) DeleteRoundByes(ctx context.Context, arg DeleteRoundByesParams) error {
+	_, err := q.db.Exec(ctx, deleteRoundByes, arg.CompetitionID, arg.Season, arg.RoundNumber)
+	return err
+}
+
+const getNextFinalsWeek = `-- name: GetNextFinalsWeek :one
+SELECT (COALESCE(MAX(finals_week), 0) + 1)::INTEGER AS finals_week
+FROM rounds
+WHERE 
+  competition_id = $1
+  AND season = $2
+  AND number < $3
+  AND finals_week IS NOT NULL
+`
+
+type GetNextFinalsWeekParams struct {
+	CompetitionID int64
+	Season        int32
+	Number        int32
+}
+
+// Retrieve the finals week that follows the finals rounds played before the
+// given round number, or 1 if no finals rounds have been played yet.
+func (q *Queries) GetNextFinalsWeek(ctx context.Context, arg GetNextFinalsWeekParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getNextFinalsWeek, arg.CompetitionID, arg.Season, arg.Number)
+	var finals_week int32
+	err := row.Scan(&finals_week)
+	return finals_week, err
+}
+
+const getRoundBySlug = `-- name: GetRoundBySlug :one
+SELECT competition_id, season, number, title, type, first_kickoff, last_kickoff, slug, finals_week, points_weight FROM rounds r
+WHERE 
+  r.competition_id = $1
+  AND r.slug = $2
+  AND r.season = COALESCE(
+    $3::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = r.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM rounds WHERE competition_id = r.competition_id)
+  )
+ORDER BY r.number
+LIMIT 1
+`
+
+type GetRoundBySlugParams struct {
+	CompetitionID int64
+	Slug          string
+	Season        *int32
+}
+
+// Retrieve a round of a competition season by its slug (e.g., grand-final).
+// If no season is given, the season of the competition's current round is used,
+// or its latest season if it has no current round.
+func (q *Queries) GetRoundBySlug(ctx context.Context, arg GetRoundBySlugParams) (*Round, error) {
+	row := q.db.QueryRow(ctx, getRoundBySlug, arg.CompetitionID, arg.Slug, arg.Season)
+	var i Round
+	err := row.Scan(
+		&i.CompetitionID,
+		&i.Season,
+		&i.Number,
+		&i.Title,
+		&i.Type,
+		&i.FirstKickoff,
+		&i.LastKickoff,
+		&i.Slug,
+		&i.FinalsWeek,
+		&i.PointsWeight,
+	)
+	return &i, err
+}
+
+const listAllRounds = `-- name: ListAllRounds :many
+SELECT competition_id, season, number, title, type, first_kickoff, last_kickoff, slug, finals_week, points_weight FROM rounds
+ORDER BY competition_id, season, number
+`
+
+// Retrieve every round of every competition season.
+func (q *Queries) ListAllRounds(ctx context.Context) ([]*Round, error) {
+	rows, err := q.db.Query(ctx, listAllRounds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Round
+	for rows.Next() {
+		var i Round
+		if err := rows.Scan(
+			&i.CompetitionID,
+			&i.Season,
+			&i.Number,
+			&i.Title,
+			&i.Type,
+			&i.FirstKickoff,
+			&i.LastKickoff,
+			&i.Slug,
+			&i.FinalsWeek,
+			&i.PointsWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoundByesByCompetitionID = `-- name: ListRoundByesByCompetitionID :many
+SELECT 
+  rb.round_number,
+  t.id, t.nickname, t.name, t.code, t.primary_colour, t.secondary_colour, t.logo_url
+FROM round_byes rb
+JOIN teams t ON rb.team_id = t.id
+WHERE 
+  rb.competition_id = $1
+  AND rb.season = COALESCE(
+    $2::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = rb.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM rounds WHERE competition_id = rb.competition_id)
+  )
+ORDER BY rb.round_number, t.nickName
+`
+
+type ListRoundByesByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+}
+
+type ListRoundByesByCompetitionIDRow struct {
+	RoundNumber int32
+	Team        Team
+}
+
+// Retrieve the teams on the bye for every round of a competition season.
+// If no season is given, the season of the competition's current round is used,
+// or its latest season if it has no current round.
+func (q *Queries) ListRoundByesByCompetitionID(ctx context.Context, arg ListRoundByesByCompetitionIDParams) ([]*ListRoundByesByCompetitionIDRow, error) {
+	rows, err := q.db.Query(ctx, listRoundByesByCompetitionID, arg.CompetitionID, arg.Season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListRoundByesByCompetitionIDRow
+	for rows.Next() {
+		var i ListRoundByesByCompetitionIDRow
+		if err := rows.Scan(
+			&i.RoundNumber,
+			&i.Team.ID,
+			&i.Team.Nickname,
+			&i.Team.Name,
+			&i.Team.Code,
+			&i.Team.PrimaryColour,
+			&i.Team.SecondaryColour,
+			&i.Team.LogoUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoundsByCompetitionID = `-- name: ListRoundsByCompetitionID :many
+SELECT competition_id, season, number, title, type, first_kickoff, last_kickoff, slug, finals_week, points_weight FROM rounds r
+WHERE 
+  r.competition_id = $1
+  AND r.season = COALESCE(
+    $2::INTEGER,
+    (SELECT MAX(season) FROM competition_seasons WHERE competition_id = r.competition_id AND round IS NOT NULL),
+    (SELECT MAX(season) FROM rounds WHERE competition_id = r.competition_id)
+  )
+ORDER BY r.number
+`
+
+type ListRoundsByCompetitionIDParams struct {
+	CompetitionID int64
+	Season        *int32
+}
+
+// Retrieve all rounds of a competition season, ordered by round number.
+// If no season is given, the season of the competition's current round is used,
+// or its latest season if it has no current round.
+func (q *Queries) ListRoundsByCompetitionID(ctx context.Context, arg ListRoundsByCompetitionIDParams) ([]*Round, error) {
+	rows, err := q.db.Query(ctx, listRoundsByCompetitionID, arg.CompetitionID, arg.Season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Round
+	for rows.Next() {
+		var i Round
+		if err := rows.Scan(
+			&i.CompetitionID,
+			&i.Season,
+			&i.Number,
+			&i.Title,
+			&i.Type,
+			&i.FirstKickoff,
+			&i.LastKickoff,
+			&i.Slug,
+			&i.FinalsWeek,
+			&i.PointsWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshRoundKickOffs = `-- name: RefreshRoundKickOffs :one
+UPDATE rounds r
+SET 
+  first_kickoff = k.first_kickoff,
+  last_kickoff = k.last_kickoff
+FROM (
+  SELECT 
+    MIN(f.kickOffTime)::TIMESTAMPTZ AS first_kickoff, 
+    MAX(f.kickOffTime)::TIMESTAMPTZ AS last_kickoff
+  FROM fixtures f
+  WHERE 
+    f.competition_id = $1
+    AND f.season = $2
+    AND f.round_number = $3
+) k
+WHERE 
+  r.competition_id = $1
+  AND r.season = $2
+  AND r.number = $3
+RETURNING r.competition_id, r.season, r.number, r.title, r.type, r.first_kickoff, r.last_kickoff, r.slug, r.finals_week, r.points_weight
+`
+
+type RefreshRoundKickOffsParams struct {
+	CompetitionID int64
+	Season        int32
+	Number        int32
+}
+
+// Recalculate the first and last kickoff times of a round from its fixtures.
+func (q *Queries) RefreshRoundKickOffs(ctx context.Context, arg RefreshRoundKickOffsParams) (*Round, error) {
+	row := q.db.QueryRow(ctx, refreshRoundKickOffs, arg.CompetitionID, arg.Season, arg.Number)
+	var i Round
+	err := row.Scan(
+		&i.CompetitionID,
+		&i.Season,
+		&i.Number,
+		&i.Title,
+		&i.Type,
+		&i.FirstKickoff,
+		&i.LastKickoff,
+		&i.Slug,
+		&i.FinalsWeek,
+		&i.PointsWeight,
+	)
+	return &i, err
+}
+
+const updateRoundPointsWeight = `-- name: UpdateRoundPointsWeight :exec
+UPDATE rounds
+SET points_weight = $4
+WHERE competition_id = $1 AND season = $2 AND number = $3
+`
+
+type UpdateRoundPointsWeightParams struct {
+	CompetitionID int64
+	Season        int32
+	Number        int32
+	PointsWeight  int32
+}
+
+// Set the multiplier applied to the points of tips on fixtures in a round.
+func (q *Queries) UpdateRoundPointsWeight(ctx context.Context, arg UpdateRoundPointsWeightParams) error {
+	_, err := q.db.Exec(ctx, updateRoundPointsWeight,
+		arg.CompetitionID,
+		arg.Season,
+		arg.Number,
+		arg.PointsWeight,
+	)
+	return err
+}
+
+const upsertRound = `-- name: UpsertRound :one
+INSERT INTO rounds (competition_id, season, number, title, slug, type, finals_week, points_weight)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (competition_id, season, number) DO UPDATE
+SET 
+  title = EXCLUDED.title,
+  slug = EXCLUDED.slug,
+  type = EXCLUDED.type,
+  finals_week = EXCLUDED.finals_week,
+  points_weight = EXCLUDED.points_weight
+RETURNING competition_id, season, number, title, type, first_kickoff, last_kickoff, slug, finals_week, points_weight
+`
+
+type UpsertRoundParams struct {
+	CompetitionID int64
+	Season        int32
+	Number        int32
+	Title         string
+	Slug          string
+	Type          string
+	FinalsWeek    *int32
+	PointsWeight  int32
+}
+
+// Insert a round, or update the title, type and finals metadata of an existing round.
+func (q *Queries) UpsertRound(ctx context.Context, arg UpsertRoundParams) (*Round, error) {
+	row := q.db.QueryRow(ctx, upsertRound,
+		arg.CompetitionID,
+		arg.Season,
+		arg.Number,
+		arg.Title,
+		arg.Slug,
+		arg.Type,
+		arg.FinalsWeek,
+		arg.PointsWeight,
+	)
+	var i Round
+	err := row.Scan(
+		&i.CompetitionID,
+		&i.Season,
+		&i.Number,
+		&i.Title,
+		&i.Type,
+		&i.FirstKickoff,
+		&i.LastKickoff,
+		&i.Slug,
+		&i.FinalsWeek,
+		&i.PointsWeight,
+	)
+	return &i, err
+}
@@ -0,0 +1,231 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: team_lists.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createTeamListChange = `-- name: CreateTeamListChange :exec
+INSERT INTO team_list_changes (fixture_id, team_id, player_id, change, number, position)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateTeamListChangeParams struct {
+	FixtureID int64
+	TeamID    int64
+	PlayerID  int64
+	Change    string
+	Number    int32
+	Position  string
+}
+
+// Record a change to a team's list after it was first announced.
+func (q *Queries) CreateTeamListChange(ctx context.Context, arg CreateTeamListChangeParams) error {
+	_, err := q.db.Exec(ctx, createTeamListChange,
+		arg.FixtureID,
+		arg.TeamID,
+		arg.PlayerID,
+		arg.Change,
+		arg.Number,
+		arg.Position,
+	)
+	return err
+}
+
+const createTeamListPlayer = `-- name: CreateTeamListPlayer :exec
+INSERT INTO team_lists (fixture_id, team_id, player_id, number, position)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING
+`
+
+type CreateTeamListPlayerParams struct {
+	FixtureID int64
+	TeamID    int64
+	PlayerID  int64
+	Number    int32
+	Position  string
+}
+
+// Name a player in a team's list for a fixture.
+func (q *Queries) CreateTeamListPlayer(ctx context.Context, arg CreateTeamListPlayerParams) error {
+	_, err := q.db.Exec(ctx, createTeamListPlayer,
+		arg.FixtureID,
+		arg.TeamID,
+		arg.PlayerID,
+		arg.Number,
+		arg.Position,
+	)
+	return err
+}
+
+const deleteTeamListPlayer = `-- name: DeleteTeamListPlayer :exec
+DELETE FROM team_lists
+WHERE 
+  fixture_id = $1
+  AND team_id = $2
+  AND player_id = $3
+`
+
+type DeleteTeamListPlayerParams struct {
+	FixtureID int64
+	TeamID    int64
+	PlayerID  int64
+}
+
+// Remove a player from a team's list for a fixture.
+func (q *Queries) DeleteTeamListPlayer(ctx context.Context, arg DeleteTeamListPlayerParams) error {
+	_, err := q.db.Exec(ctx, deleteTeamListPlayer, arg.FixtureID, arg.TeamID, arg.PlayerID)
+	return err
+}
+
+const listTeamListChangesByFixtureID = `-- name: ListTeamListChangesByFixtureID :many
+SELECT 
+  tlc.id, tlc.fixture_id, tlc.team_id, tlc.player_id, tlc.change, tlc.number, tlc.position, tlc.detected_at, 
+  p.id, p.first_name, p.last_name
+FROM team_list_changes tlc
+JOIN players p ON tlc.player_id = p.id
+WHERE tlc.fixture_id = $1
+ORDER BY tlc.detected_at, tlc.id
+`
+
+type ListTeamListChangesByFixtureIDRow struct {
+	TeamListChange TeamListChange
+	Player         Player
+}
+
+// Retrieve the changes to both team lists of a fixture, in the order they were detected.
+func (q *Queries) ListTeamListChangesByFixtureID(ctx context.Context, fixtureID int64) ([]*ListTeamListChangesByFixtureIDRow, error) {
+	rows, err := q.db.Query(ctx, listTeamListChangesByFixtureID, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTeamListChangesByFixtureIDRow
+	for rows.Next() {
+		var i ListTeamListChangesByFixtureIDRow
+		if err := rows.Scan(
+			&i.TeamListChange.ID,
+			&i.TeamListChange.FixtureID,
+			&i.TeamListChange.TeamID,
+			&i.TeamListChange.PlayerID,
+			&i.TeamListChange.Change,
+			&i.TeamListChange.Number,
+			&i.TeamListChange.Position,
+			&i.TeamListChange.DetectedAt,
+			&i.Player.ID,
+			&i.Player.FirstName,
+			&i.Player.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamListsByFixtureID = `-- name: ListTeamListsByFixtureID :many
+SELECT 
+  tl.fixture_id, tl.team_id, tl.player_id, tl.number, tl.position, 
+  p.id, p.first_name, p.last_name
+FROM team_lists tl
+JOIN players p ON tl.player_id = p.id
+WHERE tl.fixture_id = $1
+ORDER BY tl.team_id, tl.number
+`
+
+type ListTeamListsByFixtureIDRow struct {
+	TeamList TeamList
+	Player   Player
+}
+
+// Retrieve the players named by both teams of a fixture, ordered by team and jersey number.
+func (q *Queries) ListTeamListsByFixtureID(ctx context.Context, fixtureID int64) ([]*ListTeamListsByFixtureIDRow, error) {
+	rows, err := q.db.Query(ctx, listTeamListsByFixtureID, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTeamListsByFixtureIDRow
+	for rows.Next() {
+		var i ListTeamListsByFixtureIDRow
+		if err := rows.Scan(
+			&i.TeamList.FixtureID,
+			&i.TeamList.TeamID,
+			&i.TeamList.PlayerID,
+			&i.TeamList.Number,
+			&i.TeamList.Position,
+			&i.Player.ID,
+			&i.Player.FirstName,
+			&i.Player.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTeamListPlayer = `-- name: UpdateTeamListPlayer :exec
+UPDATE team_lists
+SET 
+  number = $4,
+  position = $5
+WHERE 
+  fixture_id = $1
+  AND team_id = $2
+  AND player_id = $3
+`
+
+type UpdateTeamListPlayerParams struct {
+	FixtureID int64
+	TeamID    int64
+	PlayerID  int64
+	Number    int32
+	Position  string
+}
+
+// Update the jersey number and position a player is named in for a fixture.
+func (q *Queries) UpdateTeamListPlayer(ctx context.Context, arg UpdateTeamListPlayerParams) error {
+	_, err := q.db.Exec(ctx, updateTeamListPlayer,
+		arg.FixtureID,
+		arg.TeamID,
+		arg.PlayerID,
+		arg.Number,
+		arg.Position,
+	)
+	return err
+}
+
+const upsertPlayer = `-- name: UpsertPlayer :one
+INSERT INTO players (id, first_name, last_name)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE
+SET 
+  first_name = EXCLUDED.first_name,
+  last_name = EXCLUDED.last_name
+RETURNING id, first_name, last_name
+`
+
+type UpsertPlayerParams struct {
+	ID        int64
+	FirstName string
+	LastName  string
+}
+
+// Insert a player, or update the name of an existing player.
+func (q *Queries) UpsertPlayer(ctx context.Context, arg UpsertPlayerParams) (*Player, error) {
+	row := q.db.QueryRow(ctx, upsertPlayer, arg.ID, arg.FirstName, arg.LastName)
+	var i Player
+	err := row.Scan(&i.ID, &i.FirstName, &i.LastName)
+	return &i, err
+}
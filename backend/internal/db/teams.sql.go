@@ -9,41 +9,198 @@ import (
 	"context"
 )
 
-const createTeam = `-- name: CreateTeam :one
-INSERT INTO teams (id, nickName, competition_id) 
+const addTeamCompetition = `-- name: AddTeamCompetition :exec
+INSERT INTO team_competitions (team_id, competition_id, season)
 VALUES ($1, $2, $3)
-RETURNING id, nickname, competition_id
+ON CONFLICT DO NOTHING
 `
 
-type CreateTeamParams struct {
-	ID            int64
-	Nickname      string
+type AddTeamCompetitionParams struct {
+	TeamID        int64
 	CompetitionID int64
+	Season        int32
+}
+
+// Record that a team played in a competition season.
+// If it has already been recorded, do nothing.
+func (q *Queries) AddTeamCompetition(ctx context.Context, arg AddTeamCompetitionParams) error {
+	_, err := q.db.Exec(ctx, addTeamCompetition, arg.TeamID, arg.CompetitionID, arg.Season)
+	return err
+}
+
+const createTeam = `-- name: CreateTeam :one
+INSERT INTO teams (id, nickName) 
+VALUES ($1, $2)
+RETURNING id, nickname, name, code, primary_colour, secondary_colour, logo_url
+`
+
+type CreateTeamParams struct {
+	ID       int64
+	Nickname string
 }
 
 // Insert a new team into the teams table.
-// If a team with the same team_id already exists, do nothing.
 func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (*Team, error) {
-	row := q.db.QueryRow(ctx, createTeam, arg.ID, arg.Nickname, arg.CompetitionID)
+	row := q.db.QueryRow(ctx, createTeam, arg.ID, arg.Nickname)
 	var i Team
-	err := row.Scan(&i.ID, &i.Nickname, &i.CompetitionID)
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Name,
+		&i.Code,
+		&i.PrimaryColour,
+		&i.SecondaryColour,
+		&i.LogoUrl,
+	)
 	return &i, err
 }
 
 const getTeamByID = `-- name: GetTeamByID :one
-SELECT id, nickname, competition_id FROM teams WHERE id = $1
+SELECT id, nickname, name, code, primary_colour, secondary_colour, logo_url FROM teams WHERE id = $1
 `
 
 // Retrieve a specific team by its unique identifier.
 func (q *Queries) GetTeamByID(ctx context.Context, id int64) (*Team, error) {
 	row := q.db.QueryRow(ctx, getTeamByID, id)
 	var i Team
-	err := row.Scan(&i.ID, &i.Nickname, &i.CompetitionID)
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Name,
+		&i.Code,
+		&i.PrimaryColour,
+		&i.SecondaryColour,
+		&i.LogoUrl,
+	)
+	return &i, err
+}
+
+const getTeamByNickname = `-- name: GetTeamByNickname :one
+SELECT t.id, t.nickname, t.name, t.code, t.primary_colour, t.secondary_colour, t.logo_url FROM teams t
+WHERE 
+  t.nickName = $1
+  AND EXISTS (
+    SELECT 1 FROM team_competitions tc 
+    WHERE tc.team_id = t.id AND tc.competition_id = $2
+  )
+`
+
+type GetTeamByNicknameParams struct {
+	Nickname      string
+	CompetitionID int64
+}
+
+// Retrieve a team of a competition by its nickname (e.g., Cowboys).
+func (q *Queries) GetTeamByNickname(ctx context.Context, arg GetTeamByNicknameParams) (*Team, error) {
+	row := q.db.QueryRow(ctx, getTeamByNickname, arg.Nickname, arg.CompetitionID)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Name,
+		&i.Code,
+		&i.PrimaryColour,
+		&i.SecondaryColour,
+		&i.LogoUrl,
+	)
 	return &i, err
 }
 
+const listTeamCompetitions = `-- name: ListTeamCompetitions :many
+SELECT team_id, competition_id, season FROM team_competitions
+ORDER BY team_id, competition_id, season
+`
+
+// Retrieve every competition season of every team, ordered by team, competition and season.
+func (q *Queries) ListTeamCompetitions(ctx context.Context) ([]*TeamCompetition, error) {
+	rows, err := q.db.Query(ctx, listTeamCompetitions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TeamCompetition
+	for rows.Next() {
+		var i TeamCompetition
+		if err := rows.Scan(&i.TeamID, &i.CompetitionID, &i.Season); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamCompetitionsByTeamID = `-- name: ListTeamCompetitionsByTeamID :many
+SELECT team_id, competition_id, season FROM team_competitions
+WHERE team_id = $1
+ORDER BY competition_id, season
+`
+
+// Retrieve the competition seasons a team has played in, ordered by competition and season.
+func (q *Queries) ListTeamCompetitionsByTeamID(ctx context.Context, teamID int64) ([]*TeamCompetition, error) {
+	rows, err := q.db.Query(ctx, listTeamCompetitionsByTeamID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TeamCompetition
+	for rows.Next() {
+		var i TeamCompetition
+		if err := rows.Scan(&i.TeamID, &i.CompetitionID, &i.Season); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamHomeGrounds = `-- name: ListTeamHomeGrounds :many
+SELECT 
+  f.venue, 
+  f.venueCity, 
+  COUNT(*)::INTEGER AS matches
+FROM fixtures f
+JOIN match_details md ON md.fixture_id = f.id
+WHERE md.homeTeam_id = $1
+GROUP BY f.venue, f.venueCity
+ORDER BY matches DESC, f.venue
+`
+
+type ListTeamHomeGroundsRow struct {
+	Venue     string
+	Venuecity string
+	Matches   int32
+}
+
+// Retrieve the venues a team has played home matches at, with the number of
+// home matches played at each, most used first.
+func (q *Queries) ListTeamHomeGrounds(ctx context.Context, hometeamID int64) ([]*ListTeamHomeGroundsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamHomeGrounds, hometeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTeamHomeGroundsRow
+	for rows.Next() {
+		var i ListTeamHomeGroundsRow
+		if err := rows.Scan(&i.Venue, &i.Venuecity, &i.Matches); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTeams = `-- name: ListTeams :many
-SELECT id, nickname, competition_id FROM teams
+SELECT id, nickname, name, code, primary_colour, secondary_colour, logo_url FROM teams
 `
 
 // Retrieve all teams available in the system.
@@ -56,7 +213,15 @@ func (q *Queries) ListTeams(ctx context.Context) ([]*Team, error) {
 	var items []*Team
 	for rows.Next() {
 		var i Team
-		if err := rows.Scan(&i.ID, &i.Nickname, &i.CompetitionID); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Nickname,
+			&i.Name,
+			&i.Code,
+			&i.PrimaryColour,
+			&i.SecondaryColour,
+			&i.LogoUrl,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -66,3 +231,91 @@ func (q *Queries) ListTeams(ctx context.Context) ([]*Team, error) {
 	}
 	return items, nil
 }
+
+const listTeamsByCompetitionID = `-- name: ListTeamsByCompetitionID :many
+SELECT id, nickname, name, code, primary_colour, secondary_colour, logo_url FROM teams t
+WHERE EXISTS (
+  SELECT 1 FROM team_competitions tc 
+  WHERE tc.team_id = t.id AND tc.competition_id = $1
+)
+ORDER BY t.nickName
+`
+
+// Retrieve all teams that have played in a competition in any season.
+func (q *Queries) ListTeamsByCompetitionID(ctx context.Context, competitionID int64) ([]*Team, error) {
+	rows, err := q.db.Query(ctx, listTeamsByCompetitionID, competitionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Team
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.ID,
+			&i.Nickname,
+			&i.Name,
+			&i.Code,
+			&i.PrimaryColour,
+			&i.SecondaryColour,
+			&i.LogoUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertTeam = `-- name: UpsertTeam :one
+INSERT INTO teams (id, nickName, name, code, primary_colour, secondary_colour, logo_url)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE
+SET 
+  nickName = EXCLUDED.nickName,
+  name = COALESCE(EXCLUDED.name, teams.name),
+  code = COALESCE(EXCLUDED.code, teams.code),
+  primary_colour = COALESCE(EXCLUDED.primary_colour, teams.primary_colour),
+  secondary_colour = COALESCE(EXCLUDED.secondary_colour, teams.secondary_colour),
+  logo_url = COALESCE(EXCLUDED.logo_url, teams.logo_url)
+RETURNING id, nickname, name, code, primary_colour, secondary_colour, logo_url
+`
+
+type UpsertTeamParams struct {
+	ID              int64
+	Nickname        string
+	Name            *string
+	Code            *string
+	PrimaryColour   *string
+	SecondaryColour *string
+	LogoUrl         *string
+}
+
+// Insert a team, or update the details of an existing team. Details that are
+// not given keep their existing value, so a team can be stored from a source
+// which only has its nickname.
+func (q *Queries) UpsertTeam(ctx context.Context, arg UpsertTeamParams) (*Team, error) {
+	row := q.db.QueryRow(ctx, upsertTeam,
+		arg.ID,
+		arg.Nickname,
+		arg.Name,
+		arg.Code,
+		arg.PrimaryColour,
+		arg.SecondaryColour,
+		arg.LogoUrl,
+	)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Name,
+		&i.Code,
+		&i.PrimaryColour,
+		&i.SecondaryColour,
+		&i.LogoUrl,
+	)
+	return &i, err
+}
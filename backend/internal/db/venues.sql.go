@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: venues.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getVenueByID = `-- name: GetVenueByID :one
+SELECT id, name, city, state, country, timezone FROM venues WHERE id = $1
+`
+
+// Retrieve a specific venue by its unique identifier.
+func (q *Queries) GetVenueByID(ctx context.Context, id int32) (*Venue, error) {
+	row := q.db.QueryRow(ctx, getVenueByID, id)
+	var i Venue
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.City,
+		&i.State,
+		&i.Country,
+		&i.Timezone,
+	)
+	return &i, err
+}
+
+const linkFixturesToVenue = `-- name: LinkFixturesToVenue :execrows
+UPDATE fixtures
+SET venue_id = $1
+WHERE venue = $2 AND venueCity = $3 AND venue_id IS NULL
+`
+
+type LinkFixturesToVenueParams struct {
+	VenueID   *int32
+	Venue     string
+	VenueCity string
+}
+
+// Link the fixtures played at a venue, which are not linked yet, to the venue.
+func (q *Queries) LinkFixturesToVenue(ctx context.Context, arg LinkFixturesToVenueParams) (int64, error) {
+	result, err := q.db.Exec(ctx, linkFixturesToVenue, arg.VenueID, arg.Venue, arg.VenueCity)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listUnlinkedFixtureVenues = `-- name: ListUnlinkedFixtureVenues :many
+SELECT DISTINCT venue, venueCity
+FROM fixtures
+WHERE venue_id IS NULL
+ORDER BY venueCity, venue
+`
+
+type ListUnlinkedFixtureVenuesRow struct {
+	Venue     string
+	Venuecity string
+}
+
+// Retrieve the distinct venues of fixtures which are not linked to a venue.
+func (q *Queries) ListUnlinkedFixtureVenues(ctx context.Context) ([]*ListUnlinkedFixtureVenuesRow, error) {
+	rows, err := q.db.Query(ctx, listUnlinkedFixtureVenues)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListUnlinkedFixtureVenuesRow
+	for rows.Next() {
+		var i ListUnlinkedFixtureVenuesRow
+		if err := rows.Scan(&i.Venue, &i.Venuecity); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVenues = `-- name: ListVenues :many
+SELECT id, name, city, state, country, timezone FROM venues
+ORDER BY country, city, name
+`
+
+// Retrieve all venues ordered by country, city and name.
+func (q *Queries) ListVenues(ctx context.Context) ([]*Venue, error) {
+	rows, err := q.db.Query(ctx, listVenues)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Venue
+	for rows.Next() {
+		var i Venue
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.City,
+			&i.State,
+			&i.Country,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertVenue = `-- name: UpsertVenue :one
+INSERT INTO venues (
+  name, city, state, country, timezone
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (name, city) DO UPDATE
+SET 
+  state = EXCLUDED.state,
+  country = EXCLUDED.country,
+  timezone = EXCLUDED.timezone
+RETURNING id, name, city, state, country, timezone
+`
+
+type UpsertVenueParams struct {
+	Name     string
+	City     string
+	State    *string
+	Country  *string
+	Timezone *string
+}
+
+// Insert a venue, or update the location of an existing venue, and return it.
+func (q *Queries) UpsertVenue(ctx context.Context, arg UpsertVenueParams) (*Venue, error) {
+	row := q.db.QueryRow(ctx, upsertVenue,
+		arg.Name,
+		arg.City,
+		arg.State,
+		arg.Country,
+		arg.Timezone,
+	)
+	var i Venue
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.City,
+		&i.State,
+		&i.Country,
+		&i.Timezone,
+	)
+	return &i, err
+}
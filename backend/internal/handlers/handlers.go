@@ -2,9 +2,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"regexp"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/aussiebroadwan/tipping/backend/config"
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
@@ -12,26 +15,41 @@ import (
 	"github.com/aussiebroadwan/tipping/backend/internal/utils"
 )
 
-// Handlers struct to hold the data service.
+// roundSlugPattern matches the slug of a round title (e.g. grand-final).
+var roundSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Handlers struct to hold the data and cache services.
 type Handlers struct {
-	dataService *services.APIDataService
+	dataService  *services.APIDataService
+	cacheService *services.NRLCacheService
 }
 
 // NewHandlers creates a new Handlers instance.
-func NewHandlers(dataService *services.APIDataService) *Handlers {
+func NewHandlers(dataService *services.APIDataService, cacheService *services.NRLCacheService) *Handlers {
 	return &Handlers{
-		dataService: dataService,
+		dataService:  dataService,
+		cacheService: cacheService,
 	}
 }
 
 // RegisterRoutes registers all the routes for the API.
-func RegisterRoutes(mux *http.ServeMux, dataService *services.APIDataService) *Handlers {
-	handlers := NewHandlers(dataService)
+func RegisterRoutes(mux *http.ServeMux, dataService *services.APIDataService, cacheService *services.NRLCacheService) *Handlers {
+	handlers := NewHandlers(dataService, cacheService)
 
 	mux.HandleFunc("/api/v1/competitions", handlers.GetCompetitions)
+	mux.HandleFunc("/api/v1/competitions/{competition_id}/rounds", handlers.GetCompetitionRounds)
+	mux.HandleFunc("/api/v1/competitions/{competition_id}/ladder", handlers.GetCompetitionLadder)
+	mux.HandleFunc("/api/v1/competitions/{competition_id}/ratings", handlers.GetCompetitionRatings)
+	mux.HandleFunc("/api/v1/competitions/{competition_id}/calibration", handlers.GetCompetitionCalibration)
+	mux.HandleFunc("/api/v1/competitions/{competition_id}/series", handlers.GetCompetitionSeries)
+	mux.HandleFunc("/api/v1/teams", handlers.GetTeams)
+	mux.HandleFunc("/api/v1/teams/{team_id}", handlers.GetTeam)
+	mux.HandleFunc("/api/v1/teams/{team_id}/versus/{opponent_id}", handlers.GetHeadToHead)
 	mux.HandleFunc("/api/v1/fixtures", handlers.GetFixtures)
 	mux.HandleFunc("/api/v1/fixtures/{competition_id}", handlers.GetCompetitionFixtures)
 	mux.HandleFunc("/api/v1/fixtures/{competition_id}/{match_id}", handlers.GetMatchDetails)
+	mux.HandleFunc("/api/v1/fixtures/{competition_id}/{match_id}/stats", handlers.GetMatchStats)
+	mux.HandleFunc("GET /metrics/nrl-cache", handlers.GetNRLCacheMetrics)
 
 	return handlers
 }
@@ -54,20 +72,314 @@ func (h *Handlers) GetCompetitions(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(competitions)
 }
 
+// GetCompetitionRounds retrieves the rounds of a competition season.
+// @Summary Retrieve the rounds of a competition
+// @Description Get the rounds of a competition season, including their kickoff window and the teams on the bye
+// @Tags competitions
+// @Produce json
+// @Param competition_id path int true "Competition ID" example(111)
+// @Param season query int false "Season, defaults to the current season" example(2024)
+// @Success 200 {array} models.APIRound
+// @Failure 400 "Invalid competition_id or season"
+// @Router /api/v1/competitions/{competition_id}/rounds [get]
+func (h *Handlers) GetCompetitionRounds(w http.ResponseWriter, r *http.Request) {
+	competitionID, ok := parseCompetitionID(w, r)
+	if !ok {
+		return
+	}
+
+	season, err := parseSeason(r)
+	if err != nil {
+		http.Error(w, "Invalid season query parameter", http.StatusBadRequest)
+		return
+	}
+
+	rounds, err := h.dataService.GetCompetitionRounds(competitionID, season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rounds)
+}
+
+// GetCompetitionLadder retrieves the ladder of a competition as at a round.
+// @Summary Retrieve the ladder of a competition
+// @Description Get the ladder of a competition season as at a round, ordered by position
+// @Tags competitions
+// @Produce json
+// @Param competition_id path int true "Competition ID" example(111)
+// @Param round query int false "Round number, defaults to the latest round with a ladder" example(22)
+// @Param season query int false "Season, defaults to the current season" example(2024)
+// @Success 200 {array} models.APILadderEntry
+// @Failure 400 "Invalid competition_id, round or season"
+// @Router /api/v1/competitions/{competition_id}/ladder [get]
+func (h *Handlers) GetCompetitionLadder(w http.ResponseWriter, r *http.Request) {
+	competitionID, ok := parseCompetitionID(w, r)
+	if !ok {
+		return
+	}
+
+	season, err := parseSeason(r)
+	if err != nil {
+		http.Error(w, "Invalid season query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var round *int32
+	if value := r.URL.Query().Get("round"); value != "" {
+		roundNum, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid round query parameter", http.StatusBadRequest)
+			return
+		}
+
+		round32 := int32(roundNum)
+		round = &round32
+	}
+
+	ladder, err := h.dataService.GetCompetitionLadder(competitionID, round, season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ladder)
+}
+
+// GetCompetitionRatings retrieves the ratings of the teams in a competition.
+// @Summary Retrieve the team ratings of a competition
+// @Description Get the Elo rating of each team in a competition, highest rated first, calculated by replaying the stored results
+// @Tags competitions
+// @Produce json
+// @Param competition_id path int true "Competition ID" example(111)
+// @Success 200 {array} models.APITeamRating
+// @Failure 400 "Invalid competition_id"
+// @Router /api/v1/competitions/{competition_id}/ratings [get]
+func (h *Handlers) GetCompetitionRatings(w http.ResponseWriter, r *http.Request) {
+	competitionID, ok := parseCompetitionID(w, r)
+	if !ok {
+		return
+	}
+
+	ratings, err := h.dataService.GetCompetitionRatings(competitionID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ratings)
+}
+
+// GetCompetitionCalibration retrieves the calibration of the predictions of a competition season.
+// @Summary Retrieve the calibration of the predictions of a competition
+// @Description Get the Brier score and accuracy of the predictions of the completed matches of a competition season, compared against the probabilities implied by the bookmaker odds
+// @Tags competitions
+// @Produce json
+// @Param competition_id path int true "Competition ID" example(111)
+// @Param season query int false "Season, defaults to the current season" example(2024)
+// @Success 200 {object} models.APICalibration
+// @Failure 400 "Invalid competition_id or season"
+// @Router /api/v1/competitions/{competition_id}/calibration [get]
+func (h *Handlers) GetCompetitionCalibration(w http.ResponseWriter, r *http.Request) {
+	competitionID, ok := parseCompetitionID(w, r)
+	if !ok {
+		return
+	}
+
+	season, err := parseSeason(r)
+	if err != nil {
+		http.Error(w, "Invalid season query parameter", http.StatusBadRequest)
+		return
+	}
+
+	calibration, err := h.dataService.GetCompetitionCalibration(competitionID, season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(calibration)
+}
+
+// GetCompetitionSeries retrieves the state of a series competition season.
+// @Summary Retrieve the state of a State of Origin series
+// @Description Get the series score, the games played and remaining, whether the series has been decided and which team holds the shield
+// @Tags competitions
+// @Produce json
+// @Param competition_id path int true "Competition ID" example(116)
+// @Param season query int false "Season, defaults to the current season" example(2024)
+// @Success 200 {object} models.APISeries
+// @Failure 400 "Invalid competition_id or season, or the competition is not played as a series"
+// @Failure 404 "Series not found"
+// @Router /api/v1/competitions/{competition_id}/series [get]
+func (h *Handlers) GetCompetitionSeries(w http.ResponseWriter, r *http.Request) {
+	competitionID, ok := parseCompetitionID(w, r)
+	if !ok {
+		return
+	}
+
+	if !utils.IsSeries(int(competitionID)) {
+		http.Error(w, "Competition is not played as a series", http.StatusBadRequest)
+		return
+	}
+
+	season, err := parseSeason(r)
+	if err != nil {
+		http.Error(w, "Invalid season query parameter", http.StatusBadRequest)
+		return
+	}
+
+	series, err := h.dataService.GetCompetitionSeries(competitionID, season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if series == nil {
+		http.Error(w, "Series not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(series)
+}
+
+// GetTeams retrieves all teams.
+// @Summary Retrieve a list of all teams
+// @Description Get all teams with the competitions and seasons they have played in, optionally limited to a competition
+// @Tags teams
+// @Produce json
+// @Param competition_id query int false "Only include teams that have played in the competition" example(111)
+// @Success 200 {array} models.APITeamDetails
+// @Failure 400 "Invalid competition_id"
+// @Router /api/v1/teams [get]
+func (h *Handlers) GetTeams(w http.ResponseWriter, r *http.Request) {
+	var competitionID *int64
+	if value := r.URL.Query().Get("competition_id"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid competition_id query parameter", http.StatusBadRequest)
+			return
+		}
+		competitionID = &id
+	}
+
+	teams, err := h.dataService.GetTeams(competitionID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(teams)
+}
+
+// GetTeam retrieves a specific team.
+// @Summary Retrieve a team
+// @Description Get the full record of a team, including the competitions it has played in and its home grounds
+// @Tags teams
+// @Produce json
+// @Param team_id path int true "Team ID" example(500012)
+// @Success 200 {object} models.APITeamDetails
+// @Failure 400 "Invalid team_id"
+// @Failure 404 "Team not found"
+// @Router /api/v1/teams/{team_id} [get]
+func (h *Handlers) GetTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := strconv.ParseInt(r.PathValue("team_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid team_id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	team, err := h.dataService.GetTeam(teamID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if team == nil {
+		http.Error(w, "Team not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(team)
+}
+
+// GetHeadToHead retrieves the past meetings between two teams.
+// @Summary Retrieve the head-to-head history of two teams
+// @Description Get the record of a team against an opponent from their stored results, their record at the venue of their next meeting and the scores of their last meetings
+// @Tags teams
+// @Produce json
+// @Param team_id path int true "Team ID" example(500012)
+// @Param opponent_id path int true "Opponent team ID" example(500021)
+// @Success 200 {object} models.APIHeadToHead
+// @Failure 400 "Invalid team_id or opponent_id"
+// @Failure 404 "Team not found"
+// @Router /api/v1/teams/{team_id}/versus/{opponent_id} [get]
+func (h *Handlers) GetHeadToHead(w http.ResponseWriter, r *http.Request) {
+	teamID, err := strconv.ParseInt(r.PathValue("team_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid team_id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	opponentID, err := strconv.ParseInt(r.PathValue("opponent_id"), 10, 64)
+	if err != nil || opponentID == teamID {
+		http.Error(w, "Invalid opponent_id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	headToHead, err := h.dataService.GetHeadToHead(teamID, opponentID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if headToHead == nil {
+		http.Error(w, "Team not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(headToHead)
+}
+
 // GetFixtures retrieves all fixtures.
 // @Summary Retrieve a list of all fixtures
 // @Description Get all fixtures
 // @Tags fixtures
 // @Produce json
+// @Param season query int false "Season, defaults to the current season of each competition" example(2024)
+// @Param tz query string false "IANA timezone to also return kickoff times in" example(Pacific/Auckland)
 // @Success 200 {array} models.APIFixture
+// @Failure 400 "Invalid season or tz"
 // @Router /api/v1/fixtures [get]
 func (h *Handlers) GetFixtures(w http.ResponseWriter, r *http.Request) {
-	fixtures, err := h.dataService.GetFixtures()
+	season, err := parseSeason(r)
+	if err != nil {
+		http.Error(w, "Invalid season query parameter", http.StatusBadRequest)
+		return
+	}
+
+	location, err := parseTimezone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz query parameter", http.StatusBadRequest)
+		return
+	}
+
+	fixtures, err := h.dataService.GetFixtures(season)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	for i := range fixtures {
+		setViewerKickOffTime(&fixtures[i], location)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(fixtures)
 }
@@ -78,9 +390,11 @@ func (h *Handlers) GetFixtures(w http.ResponseWriter, r *http.Request) {
 // @Tags fixtures
 // @Produce json
 // @Param competition_id path int true "Competition ID" example(111)
-// @Param round query int false "Round number" example(1)
+// @Param round query string false "Round number, round slug (e.g. finals-week-1 or grand-final), or all" example(1)
+// @Param season query int false "Season, defaults to the current season" example(2024)
+// @Param tz query string false "IANA timezone to also return kickoff times in" example(Pacific/Auckland)
 // @Success 200 {array} models.APIFixture
-// @Failure 400 "Invalid competition_id"
+// @Failure 400 "Invalid competition_id, season or tz"
 // @Router /api/v1/fixtures/{competition_id} [get]
 func (h *Handlers) GetCompetitionFixtures(w http.ResponseWriter, r *http.Request) {
 	competitionId := r.PathValue("competition_id")
@@ -103,36 +417,57 @@ func (h *Handlers) GetCompetitionFixtures(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	season, err := parseSeason(r)
+	if err != nil {
+		http.Error(w, "Invalid season query parameter", http.StatusBadRequest)
+		return
+	}
+
+	location, err := parseTimezone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz query parameter", http.StatusBadRequest)
+		return
+	}
+
 	var fixtures []models.APIFixture
 
 	round := r.URL.Query().Get("round")
 	if round == "all" {
-		fixtures, err = h.dataService.GetCompetitionFixtures(int64(competitionID))
+		fixtures, err = h.dataService.GetCompetitionFixtures(int64(competitionID), season)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-	} else if round != "" {
-		// Convert the round to an integer
-		roundNum, err := strconv.Atoi(round)
+	} else if roundNum, err := strconv.Atoi(round); err == nil {
+		fixtures, err = h.dataService.GetRoundCompetitionFixtures(int64(competitionID), roundNum, season)
 		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	} else if round != "" {
+		// Rounds without a number in their title, such as finals, are addressed by slug
+		if !roundSlugPattern.MatchString(round) {
 			http.Error(w, "Invalid round query parameter", http.StatusBadRequest)
 			return
 		}
 
-		fixtures, err = h.dataService.GetRoundCompetitionFixtures(int64(competitionID), roundNum)
+		fixtures, err = h.dataService.GetSlugRoundCompetitionFixtures(int64(competitionID), round, season)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	} else {
-		fixtures, err = h.dataService.GetCompetitionCurrentFixtures(int64(competitionID))
+		fixtures, err = h.dataService.GetCompetitionCurrentFixtures(int64(competitionID), season)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}
 
+	for i := range fixtures {
+		setViewerKickOffTime(&fixtures[i], location)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(fixtures)
 }
@@ -145,8 +480,9 @@ func (h *Handlers) GetCompetitionFixtures(w http.ResponseWriter, r *http.Request
 // @Produce json
 // @Param competition_id path int true "Competition ID" example(111)
 // @Param match_id path int true "Match ID" example(20241610510)
+// @Param tz query string false "IANA timezone to also return kickoff times in" example(Pacific/Auckland)
 // @Success 200 {object} models.APIFixture
-// @Failure 400 "Invalid competition_id or match_id, or Fixture does not belong to the specified competition"
+// @Failure 400 "Invalid competition_id, match_id or tz, or Fixture does not belong to the specified competition"
 // @Failure 500 "Internal server error"
 // @Router /api/v1/fixtures/{competition_id}/{match_id} [get]
 func (h *Handlers) GetMatchDetails(w http.ResponseWriter, r *http.Request) {
@@ -192,6 +528,12 @@ func (h *Handlers) GetMatchDetails(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	location, err := parseTimezone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz query parameter", http.StatusBadRequest)
+		return
+	}
+
 	// Fetch the fixture details from the database
 	fixture, err := h.dataService.GetFixtureDetails(int64(matchID))
 	if err != nil {
@@ -205,6 +547,137 @@ func (h *Handlers) GetMatchDetails(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setViewerKickOffTime(fixture, location)
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(fixture)
 }
+
+// GetMatchStats retrieves the timeline and team statistics for a specific match in a competition.
+//
+// @Summary Retrieve match statistics
+// @Description Get the scoring timeline and team statistics of a match, available once the match has finished.
+// @Tags fixtures
+// @Produce json
+// @Param competition_id path int true "Competition ID" example(111)
+// @Param match_id path int true "Match ID" example(20241112210)
+// @Success 200 {object} models.APIMatchStats
+// @Failure 400 "Invalid competition_id or match_id, or Fixture does not belong to the specified competition"
+// @Failure 500 "Internal server error"
+// @Router /api/v1/fixtures/{competition_id}/{match_id}/stats [get]
+func (h *Handlers) GetMatchStats(w http.ResponseWriter, r *http.Request) {
+	competitionID, ok := parseCompetitionID(w, r)
+	if !ok {
+		return
+	}
+
+	matchId := r.PathValue("match_id")
+	if matchId == "" {
+		http.Error(w, "Missing match_id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	// Convert the match ID to an integer
+	matchID, err := strconv.ParseInt(matchId, 10, 64)
+	if err != nil || len(matchId) < 10 {
+		http.Error(w, "Invalid match_id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	// Ensure the fixture belongs to the specified competition
+	if _, comp, _, _ := utils.ParseMatchID(matchId); int64(comp) != competitionID {
+		http.Error(w, "Fixture does not belong to the specified competition", http.StatusBadRequest)
+		return
+	}
+
+	stats, err := h.dataService.GetFixtureStats(matchID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(stats)
+}
+
+// parseCompetitionID parses and validates the competition_id path parameter. If
+// it is missing or invalid, an error response is written and false is returned.
+func parseCompetitionID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	competitionId := r.PathValue("competition_id")
+	if competitionId == "" {
+		http.Error(w, "Missing competition_id query parameter", http.StatusBadRequest)
+		return 0, false
+	}
+
+	// Convert the competition ID to an integer
+	competitionID, err := strconv.Atoi(competitionId)
+	if err != nil {
+		http.Error(w, "Invalid competition_id query parameter", http.StatusBadRequest)
+		return 0, false
+	}
+
+	// Check if the competition exists
+	competitions := []int{config.CompetitionNRL, config.CompetitionNRLW, config.CompetitionStateOfOrigin, config.CompetitionStateOfOriginWomens}
+	if !slices.Contains(competitions, competitionID) {
+		http.Error(w, "Invalid competition_id", http.StatusBadRequest)
+		return 0, false
+	}
+
+	return int64(competitionID), true
+}
+
+// parseSeason parses the optional season query parameter. A nil season means
+// the current season should be used.
+func parseSeason(r *http.Request) (*int32, error) {
+	value := r.URL.Query().Get("season")
+	if value == "" {
+		return nil, nil
+	}
+
+	season, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	season32 := int32(season)
+	return &season32, nil
+}
+
+// parseTimezone parses the optional tz query parameter. A nil location means
+// no viewer timezone was requested.
+func parseTimezone(r *http.Request) (*time.Location, error) {
+	value := r.URL.Query().Get("tz")
+	if value == "" {
+		return nil, nil
+	}
+
+	// Local would be the timezone of the server, not the viewer
+	if value == "Local" {
+		return nil, fmt.Errorf("unknown time zone %s", value)
+	}
+
+	return utils.LoadLocation(value)
+}
+
+// setViewerKickOffTime sets the kickoff time of a fixture in the viewer's
+// timezone, if one was requested.
+func setViewerKickOffTime(fixture *models.APIFixture, location *time.Location) {
+	if location == nil {
+		return
+	}
+
+	kickOffTime := fixture.KickOffTime.In(location)
+	fixture.ViewerKickOffTime = &kickOffTime
+}
+
+// GetNRLCacheMetrics retrieves the NRL API response cache metrics.
+// @Summary Retrieve the NRL API response cache metrics
+// @Description Get the number of NRL API requests since the server started, and how many were served from the response cache
+// @Tags metrics
+// @Produce json
+// @Success 200 {object} models.APICacheStats
+// @Router /metrics/nrl-cache [get]
+func (h *Handlers) GetNRLCacheMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.cacheService.Stats())
+}
@@ -10,21 +10,318 @@ type APICompetition struct {
 
 // APIFixture represents a fixture in the API response.
 type APIFixture struct {
-	ID            int64     `json:"id" example:"20241610510"`                     // Unique identifier for the fixture
-	CompetitionID int64     `json:"competition_id" example:"111"`                 // The competition ID this fixture belongs to
-	RoundTitle    string    `json:"round_title" example:"Round 22"`               // The title of the round
-	MatchState    string    `json:"match_state" example:"FullTime"`               // Current state of the match
-	Venue         string    `json:"venue" example:"Leichhardt Oval"`              // Venue of the match
-	VenueCity     string    `json:"venue_city" example:"Sydney"`                  // City where the venue is located
-	KickOffTime   time.Time `json:"kick_off_time" example:"2024-08-24T01:00:00Z"` // Kickoff time of the match in RFC3339 format
-	HomeTeam      APITeam   `json:"home_team"`                                    // Home team details
-	AwayTeam      APITeam   `json:"away_team"`                                    // Away team details
+	ID                int64          `json:"id" example:"20241610510"`                                                // Unique identifier for the fixture
+	CompetitionID     int64          `json:"competition_id" example:"111"`                                            // The competition ID this fixture belongs to
+	Season            int32          `json:"season" example:"2024"`                                                   // The season this fixture belongs to
+	RoundNumber       int32          `json:"round_number" example:"22"`                                               // Number of the round within the season
+	RoundTitle        string         `json:"round_title" example:"Round 22"`                                          // The title of the round
+	PointsWeight      int32          `json:"points_weight" example:"1"`                                               // Multiplier applied to the points of tips on the match
+	MatchState        string         `json:"match_state" example:"FullTime"`                                          // Current state of the match
+	Venue             string         `json:"venue" example:"Leichhardt Oval"`                                         // Venue of the match
+	VenueCity         string         `json:"venue_city" example:"Sydney"`                                             // City where the venue is located
+	VenueTimezone     string         `json:"venue_timezone" example:"Australia/Sydney"`                               // IANA timezone of the venue
+	KickOffTime       time.Time      `json:"kick_off_time" example:"2024-08-24T01:00:00Z"`                            // Kickoff time of the match in RFC3339 format
+	LocalKickOffTime  time.Time      `json:"local_kick_off_time" example:"2024-08-24T11:00:00+10:00"`                 // Kickoff time in the timezone of the venue
+	ViewerKickOffTime *time.Time     `json:"viewer_kick_off_time,omitempty" example:"2024-08-24T13:00:00+12:00"`      // Kickoff time in the timezone requested with the tz query parameter
+	HomeTeam          APITeam        `json:"home_team"`                                                               // Home team details
+	AwayTeam          APITeam        `json:"away_team"`                                                               // Away team details
+	Market            *APIMarket     `json:"market,omitempty"`                                                        // Probabilities implied by the odds, only included once both teams have odds
+	Prediction        *APIPrediction `json:"prediction,omitempty"`                                                    // Prediction of the match from the team ratings, made before kickoff
+	HeadToHeadURL     string         `json:"head_to_head_url,omitempty" example:"/api/v1/teams/500012/versus/500021"` // Path of the past meetings of the teams, only included with match details
+}
+
+// APIMarket represents the betting market of a match in the API response.
+type APIMarket struct {
+	HomeProbability        float64  `json:"home_probability" example:"0.78"`                   // Probability of the home team winning implied by the odds
+	AwayProbability        float64  `json:"away_probability" example:"0.22"`                   // Probability of the away team winning implied by the odds
+	Overround              float64  `json:"overround" example:"0.048"`                         // Bookmaker margin removed from the implied probabilities
+	FavouriteTeamID        *int64   `json:"favourite_team_id,omitempty" example:"500012"`      // Team with the higher implied probability, not set when the odds are equal
+	UnderdogTeamID         *int64   `json:"underdog_team_id,omitempty" example:"500021"`       // Team with the lower implied probability, not set when the odds are equal
+	CloseGame              bool     `json:"close_game" example:"false"`                        // Whether neither team is a clear favourite
+	OpeningHomeProbability *float64 `json:"opening_home_probability,omitempty" example:"0.74"` // Implied probability of the home team winning when the odds were first recorded
+	HomeDrift              *float64 `json:"home_drift,omitempty" example:"0.04"`               // Change in the home probability since the odds were first recorded, positive when the market has moved towards the home team
+}
+
+// APIPrediction represents the prediction of a match from the team ratings in the API response.
+type APIPrediction struct {
+	HomeRating         float64 `json:"home_rating" example:"1562.3"`         // Rating of the home team before the match
+	AwayRating         float64 `json:"away_rating" example:"1498.7"`         // Rating of the away team before the match
+	HomeWinProbability float64 `json:"home_win_probability" example:"0.713"` // Predicted probability of the home team winning
+	AwayWinProbability float64 `json:"away_win_probability" example:"0.287"` // Predicted probability of the away team winning
+	PredictedMargin    float64 `json:"predicted_margin" example:"4.5"`       // Predicted margin of the home team, negative when the away team is favoured
 }
 
 // APITeam represents a team in the API response.
 type APITeam struct {
-	Nickname string   `json:"nickname" example:"Cowboys"`    // Nickname of the team
-	Odds     *float64 `json:"odds,omitempty" example:"3.42"` // Odds for the team to win
-	Score    *int32   `json:"score,omitempty" example:"40"`  // Final score of the team
-	Form     string   `json:"form" example:"WLWWL"`          // Recent form of the team
+	ID         int64        `json:"id" example:"500012"`           // Unique identifier for the team
+	Nickname   string       `json:"nickname" example:"Cowboys"`    // Nickname of the team
+	Odds       *float64     `json:"odds,omitempty" example:"3.42"` // Odds for the team to win
+	Score      *int32       `json:"score,omitempty" example:"40"`  // Final score of the team
+	Form       string       `json:"form" example:"WLWWL"`          // Recent form of the team
+	FormDetail *APITeamForm `json:"form_detail,omitempty"`         // Recent results of the team in the competition before the match
+
+	Squad       []APIPlayer         `json:"squad,omitempty"`        // Players named in the team list, only included with match details
+	LateChanges []APITeamListChange `json:"late_changes,omitempty"` // Changes to the team list since it was announced, only included with match details
+}
+
+// APITeamForm represents the recent form of a team calculated from its stored results.
+type APITeamForm struct {
+	Results       []APIFormResult `json:"results"`                     // Most recent results first
+	Wins          int32           `json:"wins" example:"3"`            // Number of wins in the recent results
+	Losses        int32           `json:"losses" example:"1"`          // Number of losses in the recent results
+	Draws         int32           `json:"draws" example:"1"`           // Number of draws in the recent results
+	PointsFor     int32           `json:"points_for" example:"112"`    // Points scored in the recent results
+	PointsAgainst int32           `json:"points_against" example:"84"` // Points conceded in the recent results
+	Home          APIFormSplit    `json:"home"`                        // Recent results played at home
+	Away          APIFormSplit    `json:"away"`                        // Recent results played away
+}
+
+// APIFormResult represents a single result in the form of a team.
+type APIFormResult struct {
+	FixtureID     int64     `json:"fixture_id" example:"20241112510"`             // Unique identifier for the fixture
+	KickOffTime   time.Time `json:"kick_off_time" example:"2024-08-17T09:35:00Z"` // Kickoff time of the match
+	OpponentID    int64     `json:"opponent_id" example:"500021"`                 // Unique identifier for the opponent
+	Opponent      string    `json:"opponent" example:"Storm"`                     // Nickname of the opponent
+	Home          bool      `json:"home" example:"true"`                          // Whether the team played at home
+	Result        string    `json:"result" example:"W"`                           // W for a win, L for a loss or D for a draw
+	Score         int32     `json:"score" example:"42"`                           // Points scored by the team
+	OpponentScore int32     `json:"opponent_score" example:"4"`                   // Points scored by the opponent
+}
+
+// APIFormSplit represents the totals of the home or away results in the form of a team.
+type APIFormSplit struct {
+	Played        int32 `json:"played" example:"3"`          // Number of matches played
+	Wins          int32 `json:"wins" example:"2"`            // Number of wins
+	Losses        int32 `json:"losses" example:"1"`          // Number of losses
+	Draws         int32 `json:"draws" example:"0"`           // Number of draws
+	PointsFor     int32 `json:"points_for" example:"70"`     // Points scored
+	PointsAgainst int32 `json:"points_against" example:"40"` // Points conceded
+}
+
+// APIPlayer represents a player named in a team list in the API response.
+type APIPlayer struct {
+	ID       int64  `json:"id" example:"504279"`          // Unique identifier for the player
+	Name     string `json:"name" example:"Reuben Cotter"` // Full name of the player
+	Number   int32  `json:"number" example:"13"`          // Jersey number the player is named in
+	Position string `json:"position" example:"Lock"`      // Position the player is named in
+}
+
+// APITeamListChange represents a change to a team list in the API response.
+type APITeamListChange struct {
+	Player     APIPlayer `json:"player"`                                     // Player affected, with their number and position after the change (or before it if out)
+	Change     string    `json:"change" example:"Out"`                       // Type of change (In, Out or Position)
+	DetectedAt time.Time `json:"detected_at" example:"2024-08-29T05:00:00Z"` // Time the change was detected
+}
+
+// APICacheStats represents the NRL response cache metrics in the API response.
+type APICacheStats struct {
+	Requests int64   `json:"requests" example:"120"` // Number of documents fetched since startup
+	Hits     int64   `json:"hits" example:"96"`      // Documents that were unchanged since the last fetch
+	Misses   int64   `json:"misses" example:"24"`    // Documents that were new or had changed
+	HitRate  float64 `json:"hit_rate" example:"0.8"` // Fraction of fetches that were unchanged
+}
+
+// APIRound represents a round of a competition in the API response.
+type APIRound struct {
+	Number       int32      `json:"number" example:"26"`                                     // Number of the round within the season
+	Title        string     `json:"title" example:"Round 26"`                                // The title of the round
+	Slug         string     `json:"slug" example:"round-26"`                                 // URL friendly title, usable as the round of the fixtures endpoint
+	Type         string     `json:"type" example:"Regular"`                                  // Type of the round (Regular, Finals or Origin)
+	FinalsWeek   *int32     `json:"finals_week,omitempty" example:"1"`                       // Week of the finals series, only set for finals rounds
+	PointsWeight int32      `json:"points_weight" example:"1"`                               // Multiplier applied to the points of tips in the round
+	FirstKickOff *time.Time `json:"first_kick_off,omitempty" example:"2024-08-29T09:50:00Z"` // Kickoff time of the first match in the round
+	LastKickOff  *time.Time `json:"last_kick_off,omitempty" example:"2024-09-01T06:15:00Z"`  // Kickoff time of the last match in the round
+	Byes         []APIBye   `json:"byes"`                                                    // Teams on the bye for the round
+}
+
+// APIBye represents a team on the bye in the API response.
+type APIBye struct {
+	TeamID   int64  `json:"team_id" example:"500723"`    // Unique identifier for the team
+	Nickname string `json:"nickname" example:"Dolphins"` // Nickname of the team
+}
+
+// APILadderEntry represents a team's position on a competition ladder in the API response.
+type APILadderEntry struct {
+	Position         int32  `json:"position" example:"1"`            // Position of the team on the ladder
+	TeamID           int64  `json:"team_id" example:"500021"`        // Unique identifier for the team
+	Nickname         string `json:"nickname" example:"Storm"`        // Nickname of the team
+	Played           int32  `json:"played" example:"24"`             // Number of matches played
+	Wins             int32  `json:"wins" example:"19"`               // Number of matches won
+	Draws            int32  `json:"draws" example:"0"`               // Number of matches drawn
+	Losses           int32  `json:"losses" example:"5"`              // Number of matches lost
+	Byes             int32  `json:"byes" example:"3"`                // Number of byes
+	PointsFor        int32  `json:"points_for" example:"658"`        // Total points scored by the team
+	PointsAgainst    int32  `json:"points_against" example:"438"`    // Total points scored against the team
+	PointsDifference int32  `json:"points_difference" example:"220"` // Points for minus points against
+	Points           int32  `json:"points" example:"44"`             // Competition points
+}
+
+// APIMatchStats represents the post-match summary of a fixture in the API response.
+type APIMatchStats struct {
+	FixtureID int64           `json:"fixture_id" example:"20241112210"` // Unique identifier for the fixture
+	Timeline  []APIMatchEvent `json:"timeline"`                         // Events of the match in the order they occurred
+	TeamStats []APITeamStat   `json:"team_stats"`                       // Statistics of both teams
+}
+
+// APIMatchEvent represents an event in the timeline of a match in the API response.
+type APIMatchEvent struct {
+	Type        string  `json:"type" example:"Try"`                         // Type of the event (e.g., Try, Goal, SinBin)
+	Title       string  `json:"title" example:"Try"`                        // Description of the event
+	GameSeconds int32   `json:"game_seconds" example:"452"`                 // Seconds into the match the event occurred
+	TeamID      *int64  `json:"team_id,omitempty" example:"500012"`         // Team of the event, if it belongs to a team
+	Player      *string `json:"player,omitempty" example:"Jason Taumalolo"` // Name of the player involved, if known
+	HomeScore   *int32  `json:"home_score,omitempty" example:"4"`           // Score of the home team after the event
+	AwayScore   *int32  `json:"away_score,omitempty" example:"0"`           // Score of the away team after the event
+}
+
+// APITeamStat represents a statistic of both teams of a match in the API response.
+type APITeamStat struct {
+	Category  string   `json:"category" example:"Possession"`     // Group the statistic belongs to
+	Name      string   `json:"name" example:"Completion Rate"`    // Name of the statistic
+	HomeValue *float64 `json:"home_value,omitempty" example:"81"` // Value for the home team
+	AwayValue *float64 `json:"away_value,omitempty" example:"76"` // Value for the away team
+}
+
+// APITeamDetails represents the full record of a team in the API response.
+type APITeamDetails struct {
+	ID              int64                `json:"id" example:"500012"`                                                       // Unique identifier for the team
+	Nickname        string               `json:"nickname" example:"Cowboys"`                                                // Nickname of the team
+	Name            *string              `json:"name,omitempty" example:"North Queensland Toyota Cowboys"`                  // Full name of the team
+	Code            *string              `json:"code,omitempty" example:"NQL"`                                              // Short code for the team
+	PrimaryColour   *string              `json:"primary_colour,omitempty" example:"#002B5C"`                                // Primary theme colour as a hex code
+	SecondaryColour *string              `json:"secondary_colour,omitempty" example:"#FFDD02"`                              // Secondary theme colour as a hex code
+	LogoURL         *string              `json:"logo_url,omitempty" example:"https://www.nrl.com/.theme/cowboys/badge.svg"` // URL of the team badge
+	Competitions    []APITeamCompetition `json:"competitions"`                                                              // Competitions the team has played in
+	HomeGrounds     []APIHomeGround      `json:"home_grounds,omitempty"`                                                    // Venues of the team's home matches, only included for a single team
+}
+
+// APITeamCompetition represents a competition a team has played in, in the API response.
+type APITeamCompetition struct {
+	CompetitionID int64   `json:"competition_id" example:"111"` // The competition ID
+	Seasons       []int32 `json:"seasons" example:"2023,2024"`  // Seasons the team played in the competition
+}
+
+// APIHomeGround represents a venue a team has played home matches at, in the API response.
+type APIHomeGround struct {
+	Venue   string `json:"venue" example:"Queensland Country Bank Stadium"` // Name of the venue
+	City    string `json:"city" example:"Townsville"`                       // City where the venue is located
+	Matches int32  `json:"matches" example:"12"`                            // Number of home matches played at the venue
+}
+
+// APIHeadToHead represents the past meetings between two teams in the API response.
+type APIHeadToHead struct {
+	TeamID       int64                 `json:"team_id" example:"500012"`     // Unique identifier for the team
+	Team         string                `json:"team" example:"Cowboys"`       // Nickname of the team
+	OpponentID   int64                 `json:"opponent_id" example:"500021"` // Unique identifier for the opponent
+	Opponent     string                `json:"opponent" example:"Storm"`     // Nickname of the opponent
+	Overall      APIHeadToHeadRecord   `json:"overall"`                      // Record of every meeting
+	NextFixture  *APIHeadToHeadFixture `json:"next_fixture,omitempty"`       // Next meeting of the teams, if one has been scheduled
+	AtVenue      *APIHeadToHeadRecord  `json:"at_venue,omitempty"`           // Record of the meetings at the venue of the next meeting
+	LastMeetings []APIHeadToHeadResult `json:"last_meetings"`                // Most recent meetings first
+}
+
+// APIHeadToHeadRecord represents the record of a team against an opponent.
+type APIHeadToHeadRecord struct {
+	Played        int32   `json:"played" example:"10"`           // Number of meetings
+	Wins          int32   `json:"wins" example:"4"`              // Number of wins for the team
+	OpponentWins  int32   `json:"opponent_wins" example:"5"`     // Number of wins for the opponent
+	Draws         int32   `json:"draws" example:"1"`             // Number of draws
+	AverageMargin float64 `json:"average_margin" example:"-3.5"` // Average margin of the team, negative when the opponent has scored more
+}
+
+// APIHeadToHeadFixture represents the next meeting of two teams.
+type APIHeadToHeadFixture struct {
+	ID            int64     `json:"id" example:"20241112610"`                        // Unique identifier for the fixture
+	CompetitionID int64     `json:"competition_id" example:"111"`                    // The competition ID this fixture belongs to
+	RoundTitle    string    `json:"round_title" example:"Round 26"`                  // The title of the round
+	Venue         string    `json:"venue" example:"Queensland Country Bank Stadium"` // Venue of the match
+	VenueCity     string    `json:"venue_city" example:"Townsville"`                 // City where the venue is located
+	KickOffTime   time.Time `json:"kick_off_time" example:"2024-08-27T01:16:09Z"`    // Kickoff time of the match
+}
+
+// APIHeadToHeadResult represents a past meeting of two teams.
+type APIHeadToHeadResult struct {
+	FixtureID     int64     `json:"fixture_id" example:"20241110110"`             // Unique identifier for the fixture
+	CompetitionID int64     `json:"competition_id" example:"111"`                 // The competition ID this fixture belongs to
+	Season        int32     `json:"season" example:"2024"`                        // The season of the meeting
+	RoundTitle    string    `json:"round_title" example:"Round 1"`                // The title of the round
+	Venue         string    `json:"venue" example:"AAMI Park"`                    // Venue of the match
+	VenueCity     string    `json:"venue_city" example:"Melbourne"`               // City where the venue is located
+	KickOffTime   time.Time `json:"kick_off_time" example:"2024-03-08T09:00:00Z"` // Kickoff time of the match
+	Home          bool      `json:"home" example:"false"`                         // Whether the team played at home
+	Result        string    `json:"result" example:"L"`                           // W for a win, L for a loss or D for a draw
+	Score         int32     `json:"score" example:"12"`                           // Points scored by the team
+	OpponentScore int32     `json:"opponent_score" example:"30"`                  // Points scored by the opponent
+}
+
+// APITeamRating represents the rating of a team in a competition in the API response.
+type APITeamRating struct {
+	TeamID   int64   `json:"team_id" example:"500021"` // Unique identifier for the team
+	Nickname string  `json:"nickname" example:"Storm"` // Nickname of the team
+	Rating   float64 `json:"rating" example:"1612.4"`  // Elo rating of the team
+	Matches  int32   `json:"matches" example:"120"`    // Number of completed matches the rating was calculated from
+	Season   int32   `json:"season" example:"2024"`    // Latest season the rating was carried into
+}
+
+// APICalibration represents how well the predictions of a competition season
+// matched the results, compared to the bookmaker odds, in the API response.
+type APICalibration struct {
+	CompetitionID int64                  `json:"competition_id" example:"111"` // The competition ID
+	Model         APICalibrationScore    `json:"model"`                        // Predictions of every completed match
+	ModelWithOdds APICalibrationScore    `json:"model_with_odds"`              // Predictions of only the completed matches with odds
+	Odds          APICalibrationScore    `json:"odds"`                         // Probabilities implied by the odds of the completed matches with odds
+	Buckets       []APICalibrationBucket `json:"buckets"`                      // Results grouped by predicted home win probability
+}
+
+// APICalibrationScore represents the accuracy of a set of probabilities.
+type APICalibrationScore struct {
+	Matches    int32   `json:"matches" example:"204"`       // Number of matches scored
+	BrierScore float64 `json:"brier_score" example:"0.213"` // Mean squared error of the home win probabilities, lower is better
+	Accuracy   float64 `json:"accuracy" example:"0.642"`    // Proportion of matches won by the favourite
+}
+
+// APICalibrationBucket represents the results of matches with a similar predicted home win probability.
+type APICalibrationBucket struct {
+	From      float64 `json:"from" example:"0.6"`       // Lowest predicted home win probability in the bucket
+	To        float64 `json:"to" example:"0.7"`         // Highest predicted home win probability in the bucket, exclusive
+	Matches   int32   `json:"matches" example:"31"`     // Number of matches in the bucket
+	Predicted float64 `json:"predicted" example:"0.65"` // Mean predicted home win probability
+	Observed  float64 `json:"observed" example:"0.613"` // Proportion of matches won by the home team, counting draws as half
+}
+
+// APISeries represents the state of a series competition season (e.g. State of
+// Origin) in the API response.
+type APISeries struct {
+	CompetitionID      int64           `json:"competition_id" example:"116"`                     // The competition ID
+	Season             int32           `json:"season" example:"2024"`                            // The season of the series
+	Score              string          `json:"score" example:"2-1"`                              // Wins of the leading team followed by wins of the other team
+	GamesPlayed        int32           `json:"games_played" example:"3"`                         // Number of completed games
+	GamesRemaining     int32           `json:"games_remaining" example:"0"`                      // Number of games still to be played
+	Draws              int32           `json:"draws" example:"0"`                                // Number of drawn games
+	Decided            bool            `json:"decided" example:"true"`                           // Whether the remaining games can no longer change the result of the series
+	WinnerTeamID       *int64          `json:"winner_team_id,omitempty" example:"500003"`        // Team that has won the series, if it has been decided and was not drawn
+	ShieldHolderTeamID *int64          `json:"shield_holder_team_id,omitempty" example:"500003"` // Team holding the shield, the previous holder keeps it until the series is won
+	ShieldRetained     bool            `json:"shield_retained" example:"false"`                  // Whether the series has been decided and the previous holder kept the shield
+	Teams              []APISeriesTeam `json:"teams"`                                            // Teams of the series, the leading team first
+	Games              []APISeriesGame `json:"games"`                                            // Games of the series in order of kickoff
+}
+
+// APISeriesTeam represents the record of a team in a series.
+type APISeriesTeam struct {
+	TeamID   int64  `json:"team_id" example:"500003"` // Unique identifier for the team
+	Nickname string `json:"nickname" example:"Blues"` // Nickname of the team
+	Wins     int32  `json:"wins" example:"2"`         // Number of games won in the series
+}
+
+// APISeriesGame represents a game of a series.
+type APISeriesGame struct {
+	FixtureID    int64     `json:"fixture_id" example:"20241160110"`             // Unique identifier for the fixture
+	RoundTitle   string    `json:"round_title" example:"Game 1"`                 // The title of the round
+	MatchState   string    `json:"match_state" example:"FullTime"`               // Current state of the match
+	KickOffTime  time.Time `json:"kick_off_time" example:"2024-06-05T10:05:00Z"` // Kickoff time of the match
+	HomeTeamID   int64     `json:"home_team_id" example:"500003"`                // Unique identifier for the home team
+	AwayTeamID   int64     `json:"away_team_id" example:"500004"`                // Unique identifier for the away team
+	HomeScore    *int32    `json:"home_score,omitempty" example:"10"`            // Points scored by the home team, once the game has started
+	AwayScore    *int32    `json:"away_score,omitempty" example:"38"`            // Points scored by the away team, once the game has started
+	WinnerTeamID *int64    `json:"winner_team_id,omitempty" example:"500004"`    // Team that won the game, if it is complete and was not drawn
 }
@@ -1,5 +1,23 @@
 package models
 
+import "encoding/json"
+
+type NRLDraw struct {
+	SelectedSeasonID int          `json:"selectedSeasonId"`
+	SelectedRoundID  int          `json:"selectedRoundId"`
+	Fixtures         []NRLFixture `json:"fixtures"`
+	Byes             []NRLBye     `json:"byes"`
+
+	// NotModified is set when the draw document has not changed since it was
+	// last fetched.
+	NotModified bool `json:"-"`
+}
+
+type NRLBye struct {
+	TeamID int    `json:"teamId"`
+	Name   string `json:"teamNickName"`
+}
+
 type NRLFixture struct {
 	ID             string  `json:"matchId"`
 	IsCurrentRound bool    `json:"isCurrentRound"`
@@ -11,6 +29,41 @@ type NRLFixture struct {
 	HomeTeam       NRLTeam `json:"homeTeam"`
 	AwayTeam       NRLTeam `json:"awayTeam"`
 	KickOffTime    string  `json:"startTime"`
+
+	// NotModified is set when neither the draw nor match centre documents for
+	// this fixture have changed since they were last fetched.
+	NotModified bool `json:"-"`
+
+	// MatchCentre holds the additional data only found in the match centre
+	// document, it is not set for fixtures decoded from the draw alone.
+	MatchCentre *NRLMatchCentre `json:"-"`
+}
+
+type NRLMatchCentre struct {
+	HomeTeam NRLTeamList     `json:"homeTeam"`
+	AwayTeam NRLTeamList     `json:"awayTeam"`
+	Timeline []NRLMatchEvent `json:"timeline"`
+	Stats    NRLMatchStats   `json:"stats"`
+}
+
+type NRLTeamList struct {
+	TeamID  int          `json:"teamId"`
+	Name    string       `json:"name"`
+	Theme   NRLTeamTheme `json:"theme"`
+	Players []NRLPlayer  `json:"players"`
+}
+
+type NRLTeamTheme struct {
+	Key   string            `json:"key"`
+	Logos map[string]string `json:"logos"`
+}
+
+type NRLPlayer struct {
+	ID        int    `json:"playerId"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Number    int    `json:"number"`
+	Position  string `json:"position"`
 }
 
 type NRLTeam struct {
@@ -25,3 +78,81 @@ type NRLForm struct {
 	Result string `json:"result"`
 	Score  string `json:"score"`
 }
+
+type NRLLadder struct {
+	SelectedSeasonID int                 `json:"selectedSeasonId"`
+	SelectedRoundID  int                 `json:"selectedRoundId"`
+	Positions        []NRLLadderPosition `json:"positions"`
+
+	// NotModified is set when the ladder document has not changed since it was
+	// last fetched.
+	NotModified bool `json:"-"`
+}
+
+type NRLLadderPosition struct {
+	TeamNickname string         `json:"teamNickname"`
+	Stats        NRLLadderStats `json:"stats"`
+}
+
+type NRLLadderStats struct {
+	Played           int `json:"played"`
+	Wins             int `json:"wins"`
+	Drawn            int `json:"drawn"`
+	Lost             int `json:"lost"`
+	Byes             int `json:"byes"`
+	PointsFor        int `json:"points for"`
+	PointsAgainst    int `json:"points against"`
+	PointsDifference int `json:"points difference"`
+	Points           int `json:"points"`
+}
+
+type NRLMatchEvent struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	GameSeconds int    `json:"gameSeconds"`
+	TeamID      int    `json:"teamId"`
+	PlayerID    int    `json:"playerId"`
+	HomeScore   *int   `json:"homeScore,omitempty"`
+	AwayScore   *int   `json:"awayScore,omitempty"`
+}
+
+type NRLMatchStats struct {
+	Groups []NRLMatchStatGroup `json:"groups"`
+}
+
+type NRLMatchStatGroup struct {
+	Title string         `json:"title"`
+	Stats []NRLMatchStat `json:"stats"`
+}
+
+type NRLMatchStat struct {
+	Title     string       `json:"title"`
+	HomeValue NRLStatValue `json:"homeValue"`
+	AwayValue NRLStatValue `json:"awayValue"`
+}
+
+// NRLStatValue is the value of a match statistic for a team. The match centre
+// gives most values as an object (e.g. {"value": 52}) but some as a plain number,
+// so both are accepted. Values that are not numeric are left unset.
+type NRLStatValue struct {
+	Value *float64
+}
+
+func (v *NRLStatValue) UnmarshalJSON(data []byte) error {
+	v.Value = nil
+
+	var object struct {
+		Value *float64 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &object); err == nil {
+		v.Value = object.Value
+		return nil
+	}
+
+	var number float64
+	if err := json.Unmarshal(data, &number); err == nil {
+		v.Value = &number
+	}
+
+	return nil
+}
@@ -1,12 +1,21 @@
 package services
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/aussiebroadwan/tipping/backend/config"
 	"github.com/aussiebroadwan/tipping/backend/internal/db"
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
+	"github.com/aussiebroadwan/tipping/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // APIDataService defines a service for handling data conversion and integration with the database.
@@ -43,9 +52,10 @@ func (s *APIDataService) GetCompetitions() ([]models.APICompetition, error) {
 	return comps, nil
 }
 
-// GetFixtures fetches all fixtures with match details from the database and converts them to API models.
-func (s *APIDataService) GetFixtures() ([]models.APIFixture, error) {
-	fixtures, err := s.queries.ListMatchDetails(s.ctx)
+// GetFixtures fetches all fixtures with match details for a season from the database and
+// converts them to API models. If season is nil, the current season of each competition is used.
+func (s *APIDataService) GetFixtures(season *int32) ([]models.APIFixture, error) {
+	fixtures, err := s.queries.ListMatchDetails(s.ctx, season)
 	if err != nil {
 		return nil, err
 	}
@@ -53,35 +63,23 @@ func (s *APIDataService) GetFixtures() ([]models.APIFixture, error) {
 	// Convert database models to API models.
 	apiFixtures := make([]models.APIFixture, 0)
 	for _, f := range fixtures {
-		apiFixtures = append(apiFixtures, models.APIFixture{
-			ID:            f.Fixture.ID,
-			CompetitionID: f.Fixture.CompetitionID,
-			RoundTitle:    f.Fixture.Roundtitle,
-			MatchState:    f.Fixture.Matchstate,
-			Venue:         f.Fixture.Venue,
-			VenueCity:     f.Fixture.Venuecity,
-			HomeTeam: models.APITeam{
-				Nickname: f.Team.Nickname,
-				Score:    f.MatchDetail.HometeamScore,
-				Odds:     f.MatchDetail.HometeamOdds,
-				Form:     f.MatchDetail.HometeamForm,
-			},
-			AwayTeam: models.APITeam{
-				Nickname: f.Team_2.Nickname,
-				Score:    f.MatchDetail.AwayteamScore,
-				Odds:     f.MatchDetail.AwayteamOdds,
-				Form:     f.MatchDetail.AwayteamForm,
-			},
-			KickOffTime: f.Fixture.Kickofftime.Time,
-		})
+		apiFixtures = append(apiFixtures, newAPIFixture(f.MatchDetail, f.Fixture, f.Team, f.Team_2, f.PointsWeight, f.VenueTimezone))
+	}
+
+	if err := s.addFixtureContext(apiFixtures); err != nil {
+		return nil, err
 	}
 
 	return apiFixtures, nil
 }
 
-// GetCompetitionFixtures fetches fixtures for a specific competition and converts them to API models.
-func (s *APIDataService) GetCompetitionFixtures(competitionId int64) ([]models.APIFixture, error) {
-	fixtures, err := s.queries.ListMatchDetailsByCompetitionID(s.ctx, competitionId)
+// GetCompetitionFixtures fetches fixtures for a specific competition and season and converts them
+// to API models. If season is nil, the current season of the competition is used.
+func (s *APIDataService) GetCompetitionFixtures(competitionId int64, season *int32) ([]models.APIFixture, error) {
+	fixtures, err := s.queries.ListMatchDetailsByCompetitionID(s.ctx, db.ListMatchDetailsByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -89,35 +87,23 @@ func (s *APIDataService) GetCompetitionFixtures(competitionId int64) ([]models.A
 	// Convert database models to API models.
 	apiFixtures := make([]models.APIFixture, 0)
 	for _, f := range fixtures {
-		apiFixtures = append(apiFixtures, models.APIFixture{
-			ID:            f.Fixture.ID,
-			CompetitionID: f.Fixture.CompetitionID,
-			RoundTitle:    f.Fixture.Roundtitle,
-			MatchState:    f.Fixture.Matchstate,
-			Venue:         f.Fixture.Venue,
-			VenueCity:     f.Fixture.Venuecity,
-			HomeTeam: models.APITeam{
-				Nickname: f.Team.Nickname,
-				Score:    f.MatchDetail.HometeamScore,
-				Odds:     f.MatchDetail.HometeamOdds,
-				Form:     f.MatchDetail.HometeamForm,
-			},
-			AwayTeam: models.APITeam{
-				Nickname: f.Team_2.Nickname,
-				Score:    f.MatchDetail.AwayteamScore,
-				Odds:     f.MatchDetail.AwayteamOdds,
-				Form:     f.MatchDetail.AwayteamForm,
-			},
-			KickOffTime: f.Fixture.Kickofftime.Time,
-		})
+		apiFixtures = append(apiFixtures, newAPIFixture(f.MatchDetail, f.Fixture, f.Team, f.Team_2, f.PointsWeight, f.VenueTimezone))
+	}
+
+	if err := s.addFixtureContext(apiFixtures); err != nil {
+		return nil, err
 	}
 
 	return apiFixtures, nil
 }
 
 // GetCompetitionFixtures fetches fixtures for a specific competition for the current/latest round and converts them to API models.
-func (s *APIDataService) GetCompetitionCurrentFixtures(competitionId int64) ([]models.APIFixture, error) {
-	fixtures, err := s.queries.ListCurrentRoundMatchDetailsByCompetitionID(s.ctx, competitionId)
+// If season is nil, the current season of the competition is used.
+func (s *APIDataService) GetCompetitionCurrentFixtures(competitionId int64, season *int32) ([]models.APIFixture, error) {
+	fixtures, err := s.queries.ListCurrentRoundMatchDetailsByCompetitionID(s.ctx, db.ListCurrentRoundMatchDetailsByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -125,42 +111,23 @@ func (s *APIDataService) GetCompetitionCurrentFixtures(competitionId int64) ([]m
 	// Convert database models to API models.
 	apiFixtures := make([]models.APIFixture, 0)
 	for _, f := range fixtures {
-		apiFixtures = append(apiFixtures, models.APIFixture{
-			ID:            f.Fixture.ID,
-			CompetitionID: f.Fixture.CompetitionID,
-			RoundTitle:    f.Fixture.Roundtitle,
-			MatchState:    f.Fixture.Matchstate,
-			Venue:         f.Fixture.Venue,
-			VenueCity:     f.Fixture.Venuecity,
-			HomeTeam: models.APITeam{
-				Nickname: f.Team.Nickname,
-				Score:    f.MatchDetail.HometeamScore,
-				Odds:     f.MatchDetail.HometeamOdds,
-				Form:     f.MatchDetail.HometeamForm,
-			},
-			AwayTeam: models.APITeam{
-				Nickname: f.Team_2.Nickname,
-				Score:    f.MatchDetail.AwayteamScore,
-				Odds:     f.MatchDetail.AwayteamOdds,
-				Form:     f.MatchDetail.AwayteamForm,
-			},
-			KickOffTime: f.Fixture.Kickofftime.Time,
-		})
+		apiFixtures = append(apiFixtures, newAPIFixture(f.MatchDetail, f.Fixture, f.Team, f.Team_2, f.PointsWeight, f.VenueTimezone))
+	}
+
+	if err := s.addFixtureContext(apiFixtures); err != nil {
+		return nil, err
 	}
 
 	return apiFixtures, nil
 }
 
-// GetCompetitionFixtures fetches fixtures for a specific competition and converts them to API models.
-func (s *APIDataService) GetRoundCompetitionFixtures(competitionId int64, round int) ([]models.APIFixture, error) {
-	roundTitle := fmt.Sprintf("Round %d", round)
-	if competitionId == config.CompetitionStateOfOrigin || competitionId == config.CompetitionStateOfOriginWomens {
-		roundTitle = fmt.Sprintf("Game %d", round)
-	}
-
+// GetCompetitionFixtures fetches fixtures for a specific competition and round number and converts them to API models.
+// If season is nil, the current season of the competition is used.
+func (s *APIDataService) GetRoundCompetitionFixtures(competitionId int64, round int, season *int32) ([]models.APIFixture, error) {
 	fixtures, err := s.queries.ListRoundMatchDetailsByCompetitionID(s.ctx, db.ListRoundMatchDetailsByCompetitionIDParams{
 		CompetitionID: competitionId,
-		Roundtitle:    roundTitle,
+		RoundNumber:   int32(round),
+		Season:        season,
 	})
 	if err != nil {
 		return nil, err
@@ -169,32 +136,35 @@ func (s *APIDataService) GetRoundCompetitionFixtures(competitionId int64, round
 	// Convert database models to API models.
 	apiFixtures := make([]models.APIFixture, 0)
 	for _, f := range fixtures {
-		apiFixtures = append(apiFixtures, models.APIFixture{
-			ID:            f.Fixture.ID,
-			CompetitionID: f.Fixture.CompetitionID,
-			RoundTitle:    f.Fixture.Roundtitle,
-			MatchState:    f.Fixture.Matchstate,
-			Venue:         f.Fixture.Venue,
-			VenueCity:     f.Fixture.Venuecity,
-			HomeTeam: models.APITeam{
-				Nickname: f.Team.Nickname,
-				Score:    f.MatchDetail.HometeamScore,
-				Odds:     f.MatchDetail.HometeamOdds,
-				Form:     f.MatchDetail.HometeamForm,
-			},
-			AwayTeam: models.APITeam{
-				Nickname: f.Team_2.Nickname,
-				Score:    f.MatchDetail.AwayteamScore,
-				Odds:     f.MatchDetail.AwayteamOdds,
-				Form:     f.MatchDetail.AwayteamForm,
-			},
-			KickOffTime: f.Fixture.Kickofftime.Time,
-		})
+		apiFixtures = append(apiFixtures, newAPIFixture(f.MatchDetail, f.Fixture, f.Team, f.Team_2, f.PointsWeight, f.VenueTimezone))
+	}
+
+	if err := s.addFixtureContext(apiFixtures); err != nil {
+		return nil, err
 	}
 
 	return apiFixtures, nil
 }
 
+// GetSlugRoundCompetitionFixtures fetches fixtures for the round of a competition with the given
+// slug (e.g., grand-final) and converts them to API models. If no round has the slug, no fixtures
+// are returned. If season is nil, the current season of the competition is used.
+func (s *APIDataService) GetSlugRoundCompetitionFixtures(competitionId int64, slug string, season *int32) ([]models.APIFixture, error) {
+	round, err := s.queries.GetRoundBySlug(s.ctx, db.GetRoundBySlugParams{
+		CompetitionID: competitionId,
+		Slug:          slug,
+		Season:        season,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return make([]models.APIFixture, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRoundCompetitionFixtures(competitionId, int(round.Number), &round.Season)
+}
+
 // GetFixtureDetails fetches details for a specific fixture and converts them to API models.
 func (s *APIDataService) GetFixtureDetails(fixtureId int64) (*models.APIFixture, error) {
 	fixture, err := s.queries.GetMatchDetailsByFixtureID(s.ctx, fixtureId)
@@ -202,27 +172,948 @@ func (s *APIDataService) GetFixtureDetails(fixtureId int64) (*models.APIFixture,
 		return nil, err
 	}
 
-	apiFixture := models.APIFixture{
-		ID:            fixture.Fixture.ID,
-		CompetitionID: fixture.Fixture.CompetitionID,
-		RoundTitle:    fixture.Fixture.Roundtitle,
-		MatchState:    fixture.Fixture.Matchstate,
-		Venue:         fixture.Fixture.Venue,
-		VenueCity:     fixture.Fixture.Venuecity,
+	apiFixture := newAPIFixture(fixture.MatchDetail, fixture.Fixture, fixture.Team, fixture.Team_2, fixture.PointsWeight, fixture.VenueTimezone)
+
+	apiFixtures := []models.APIFixture{apiFixture}
+	if err := s.addFixtureContext(apiFixtures); err != nil {
+		return nil, err
+	}
+	apiFixture = apiFixtures[0]
+	apiFixture.HeadToHeadURL = fmt.Sprintf("/api/v1/teams/%d/versus/%d", apiFixture.HomeTeam.ID, apiFixture.AwayTeam.ID)
+
+	// Include the named squads and any changes since they were announced
+	teamLists, err := s.queries.ListTeamListsByFixtureID(s.ctx, fixtureId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range teamLists {
+		team := apiFixtureTeam(&apiFixture, fixture.Team.ID, l.TeamList.TeamID)
+		team.Squad = append(team.Squad, newAPIPlayer(l.Player, l.TeamList.Number, l.TeamList.Position))
+	}
+
+	changes, err := s.queries.ListTeamListChangesByFixtureID(s.ctx, fixtureId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range changes {
+		team := apiFixtureTeam(&apiFixture, fixture.Team.ID, c.TeamListChange.TeamID)
+		team.LateChanges = append(team.LateChanges, models.APITeamListChange{
+			Player:     newAPIPlayer(c.Player, c.TeamListChange.Number, c.TeamListChange.Position),
+			Change:     c.TeamListChange.Change,
+			DetectedAt: c.TeamListChange.DetectedAt.Time.UTC(),
+		})
+	}
+
+	return &apiFixture, nil
+}
+
+// GetFixtureStats fetches the timeline and team statistics of a fixture and converts them to
+// API models. Both are empty until the match has finished.
+func (s *APIDataService) GetFixtureStats(fixtureId int64) (*models.APIMatchStats, error) {
+	fixture, err := s.queries.GetMatchDetailsByFixtureID(s.ctx, fixtureId)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.queries.ListMatchEventsByFixtureID(s.ctx, fixtureId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.queries.ListMatchTeamStatsByFixtureID(s.ctx, fixtureId)
+	if err != nil {
+		return nil, err
+	}
+
+	matchStats := models.APIMatchStats{
+		FixtureID: fixtureId,
+		Timeline:  make([]models.APIMatchEvent, 0),
+		TeamStats: make([]models.APITeamStat, 0),
+	}
+
+	for _, e := range events {
+		event := models.APIMatchEvent{
+			Type:        e.MatchEvent.Type,
+			Title:       e.MatchEvent.Title,
+			GameSeconds: e.MatchEvent.GameSeconds,
+			TeamID:      e.MatchEvent.TeamID,
+			HomeScore:   e.MatchEvent.HometeamScore,
+			AwayScore:   e.MatchEvent.AwayteamScore,
+		}
+		if e.FirstName != nil && e.LastName != nil {
+			name := *e.FirstName + " " + *e.LastName
+			event.Player = &name
+		}
+
+		matchStats.Timeline = append(matchStats.Timeline, event)
+	}
+
+	// Combine the home and away values of each statistic, which are ordered together.
+	for _, stat := range stats {
+		last := len(matchStats.TeamStats) - 1
+		if last < 0 || matchStats.TeamStats[last].Category != stat.Category || matchStats.TeamStats[last].Name != stat.Name {
+			matchStats.TeamStats = append(matchStats.TeamStats, models.APITeamStat{
+				Category: stat.Category,
+				Name:     stat.Name,
+			})
+			last++
+		}
+
+		if stat.TeamID == fixture.MatchDetail.HometeamID {
+			matchStats.TeamStats[last].HomeValue = stat.Value
+		} else {
+			matchStats.TeamStats[last].AwayValue = stat.Value
+		}
+	}
+
+	return &matchStats, nil
+}
+
+// GetCompetitionRounds fetches the rounds of a competition season, including the teams on
+// the bye, and converts them to API models. If season is nil, the current season is used.
+func (s *APIDataService) GetCompetitionRounds(competitionId int64, season *int32) ([]models.APIRound, error) {
+	rounds, err := s.queries.ListRoundsByCompetitionID(s.ctx, db.ListRoundsByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byes, err := s.queries.ListRoundByesByCompetitionID(s.ctx, db.ListRoundByesByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the teams on the bye by round number.
+	roundByes := make(map[int32][]models.APIBye)
+	for _, b := range byes {
+		roundByes[b.RoundNumber] = append(roundByes[b.RoundNumber], models.APIBye{
+			TeamID:   b.Team.ID,
+			Nickname: b.Team.Nickname,
+		})
+	}
+
+	// Convert database models to API models.
+	apiRounds := make([]models.APIRound, 0)
+	for _, r := range rounds {
+		apiRound := models.APIRound{
+			Number:       r.Number,
+			Title:        r.Title,
+			Slug:         r.Slug,
+			Type:         r.Type,
+			FinalsWeek:   r.FinalsWeek,
+			PointsWeight: r.PointsWeight,
+			Byes:         make([]models.APIBye, 0),
+		}
+		if r.FirstKickoff.Valid {
+			firstKickOff := r.FirstKickoff.Time.UTC()
+			apiRound.FirstKickOff = &firstKickOff
+		}
+		if r.LastKickoff.Valid {
+			lastKickOff := r.LastKickoff.Time.UTC()
+			apiRound.LastKickOff = &lastKickOff
+		}
+		if b, ok := roundByes[r.Number]; ok {
+			apiRound.Byes = b
+		}
+
+		apiRounds = append(apiRounds, apiRound)
+	}
+
+	return apiRounds, nil
+}
+
+// GetCompetitionLadder fetches the ladder of a competition as at a round and converts it to API
+// models. If season is nil, the current season is used, and if round is nil, the latest round
+// with a ladder in that season is used.
+func (s *APIDataService) GetCompetitionLadder(competitionId int64, round *int32, season *int32) ([]models.APILadderEntry, error) {
+	entries, err := s.queries.ListLadderEntriesByCompetitionID(s.ctx, db.ListLadderEntriesByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+		Round:         round,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert database models to API models.
+	ladder := make([]models.APILadderEntry, 0)
+	for _, e := range entries {
+		ladder = append(ladder, models.APILadderEntry{
+			Position:         e.LadderEntry.Position,
+			TeamID:           e.Team.ID,
+			Nickname:         e.Team.Nickname,
+			Played:           e.LadderEntry.Played,
+			Wins:             e.LadderEntry.Wins,
+			Draws:            e.LadderEntry.Draws,
+			Losses:           e.LadderEntry.Losses,
+			Byes:             e.LadderEntry.Byes,
+			PointsFor:        e.LadderEntry.PointsFor,
+			PointsAgainst:    e.LadderEntry.PointsAgainst,
+			PointsDifference: e.LadderEntry.PointsDifference,
+			Points:           e.LadderEntry.Points,
+		})
+	}
+
+	return ladder, nil
+}
+
+// GetTeams fetches all teams, or the teams that have played in a competition if one is given,
+// along with the competitions they have played in and converts them to API models.
+func (s *APIDataService) GetTeams(competitionId *int64) ([]models.APITeamDetails, error) {
+	var teams []*db.Team
+	var err error
+	if competitionId != nil {
+		teams, err = s.queries.ListTeamsByCompetitionID(s.ctx, *competitionId)
+	} else {
+		teams, err = s.queries.ListTeams(s.ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.queries.ListTeamCompetitions(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the competition seasons by team.
+	teamCompetitions := make(map[int64][]*db.TeamCompetition)
+	for _, m := range memberships {
+		teamCompetitions[m.TeamID] = append(teamCompetitions[m.TeamID], m)
+	}
+
+	// Convert database models to API models.
+	apiTeams := make([]models.APITeamDetails, 0)
+	for _, t := range teams {
+		apiTeams = append(apiTeams, newAPITeamDetails(*t, teamCompetitions[t.ID]))
+	}
+
+	return apiTeams, nil
+}
+
+// GetTeam fetches a team with the competitions it has played in and its home grounds, and
+// converts it to an API model. If the team does not exist, nil is returned.
+func (s *APIDataService) GetTeam(teamId int64) (*models.APITeamDetails, error) {
+	team, err := s.queries.GetTeamByID(s.ctx, teamId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.queries.ListTeamCompetitionsByTeamID(s.ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	grounds, err := s.queries.ListTeamHomeGrounds(s.ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTeam := newAPITeamDetails(*team, memberships)
+	apiTeam.HomeGrounds = make([]models.APIHomeGround, 0)
+	for _, g := range grounds {
+		apiTeam.HomeGrounds = append(apiTeam.HomeGrounds, models.APIHomeGround{
+			Venue:   g.Venue,
+			City:    g.Venuecity,
+			Matches: g.Matches,
+		})
+	}
+
+	return &apiTeam, nil
+}
+
+// GetCompetitionRatings fetches the ratings of the teams in a competition, highest
+// rated first, and converts them to API models.
+func (s *APIDataService) GetCompetitionRatings(competitionId int64) ([]models.APITeamRating, error) {
+	ratings, err := s.queries.ListTeamRatingsByCompetitionID(s.ctx, competitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert database models to API models.
+	apiRatings := make([]models.APITeamRating, 0)
+	for _, r := range ratings {
+		apiRatings = append(apiRatings, models.APITeamRating{
+			TeamID:   r.Team.ID,
+			Nickname: r.Team.Nickname,
+			Rating:   roundTo(r.TeamRating.Rating, 1),
+			Matches:  r.TeamRating.Matches,
+			Season:   r.TeamRating.Season,
+		})
+	}
+
+	return apiRatings, nil
+}
+
+// GetCompetitionCalibration compares the predictions of the completed matches of a
+// competition season against their results, and against the probabilities implied
+// by the bookmaker odds of the matches that have them. If season is nil, the
+// current season of the competition is used.
+func (s *APIDataService) GetCompetitionCalibration(competitionId int64, season *int32) (*models.APICalibration, error) {
+	results, err := s.queries.ListPredictionResultsByCompetitionID(s.ctx, db.ListPredictionResultsByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var model, modelWithOdds, odds calibrationScore
+	buckets := make([]calibrationBucket, calibrationBuckets)
+	for _, r := range results {
+		// A draw counts as half a win for the home team
+		outcome := 0.5
+		if *r.HometeamScore > *r.AwayteamScore {
+			outcome = 1
+		} else if *r.HometeamScore < *r.AwayteamScore {
+			outcome = 0
+		}
+
+		model.add(r.HomeWinProbability, outcome)
+		buckets[min(int(r.HomeWinProbability*calibrationBuckets), calibrationBuckets-1)].add(r.HomeWinProbability, outcome)
+
+		if r.HometeamOdds != nil && r.AwayteamOdds != nil && *r.HometeamOdds > 0 && *r.AwayteamOdds > 0 {
+			modelWithOdds.add(r.HomeWinProbability, outcome)
+			odds.add(utils.ImpliedProbability(*r.HometeamOdds, *r.AwayteamOdds), outcome)
+		}
+	}
+
+	calibration := &models.APICalibration{
+		CompetitionID: competitionId,
+		Model:         model.apiScore(),
+		ModelWithOdds: modelWithOdds.apiScore(),
+		Odds:          odds.apiScore(),
+		Buckets:       make([]models.APICalibrationBucket, 0),
+	}
+	for i, b := range buckets {
+		if b.matches == 0 {
+			continue
+		}
+		calibration.Buckets = append(calibration.Buckets, models.APICalibrationBucket{
+			From:      roundTo(float64(i)/calibrationBuckets, 1),
+			To:        roundTo(float64(i+1)/calibrationBuckets, 1),
+			Matches:   b.matches,
+			Predicted: roundTo(b.predicted/float64(b.matches), 3),
+			Observed:  roundTo(b.observed/float64(b.matches), 3),
+		})
+	}
+
+	return calibration, nil
+}
+
+// calibrationBuckets is the number of equal ranges of predicted probability that
+// matches are grouped into for calibration.
+const calibrationBuckets = 10
+
+// calibrationScore totals the squared errors and correct picks of a set of home
+// win probabilities.
+type calibrationScore struct {
+	matches      int32
+	squaredError float64
+	correct      int32
+}
+
+func (c *calibrationScore) add(probability, outcome float64) {
+	c.matches++
+	c.squaredError += (probability - outcome) * (probability - outcome)
+	if (probability > 0.5 && outcome == 1) || (probability < 0.5 && outcome == 0) {
+		c.correct++
+	}
+}
+
+func (c *calibrationScore) apiScore() models.APICalibrationScore {
+	score := models.APICalibrationScore{Matches: c.matches}
+	if c.matches > 0 {
+		score.BrierScore = roundTo(c.squaredError/float64(c.matches), 3)
+		score.Accuracy = roundTo(float64(c.correct)/float64(c.matches), 3)
+	}
+	return score
+}
+
+// calibrationBucket totals the predicted probabilities and outcomes of the matches
+// in a range of predicted probability.
+type calibrationBucket struct {
+	matches   int32
+	predicted float64
+	observed  float64
+}
+
+func (b *calibrationBucket) add(probability, outcome float64) {
+	b.matches++
+	b.predicted += probability
+	b.observed += outcome
+}
+
+// roundTo rounds a value to a number of decimal places.
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
+
+// GetHeadToHead fetches the past meetings between two teams, along with their record
+// at the venue of their next meeting. If either team does not exist, nil is returned.
+func (s *APIDataService) GetHeadToHead(teamId, opponentId int64) (*models.APIHeadToHead, error) {
+	team, err := s.queries.GetTeamByID(s.ctx, teamId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	opponent, err := s.queries.GetTeamByID(s.ctx, opponentId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.queries.ListHeadToHeadResults(s.ctx, db.ListHeadToHeadResultsParams{
+		TeamID:     teamId,
+		OpponentID: opponentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headToHead := &models.APIHeadToHead{
+		TeamID:       team.ID,
+		Team:         team.Nickname,
+		OpponentID:   opponent.ID,
+		Opponent:     opponent.Nickname,
+		LastMeetings: make([]models.APIHeadToHeadResult, 0, config.HeadToHeadMatches),
+	}
+
+	next, err := s.queries.GetNextHeadToHeadFixture(s.ctx, db.GetNextHeadToHeadFixtureParams{
+		TeamID:     teamId,
+		OpponentID: opponentId,
+		After:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The teams have no meeting scheduled, so there is no venue to compare
+		next = nil
+	} else if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		headToHead.NextFixture = &models.APIHeadToHeadFixture{
+			ID:            next.ID,
+			CompetitionID: next.CompetitionID,
+			RoundTitle:    next.Roundtitle,
+			Venue:         next.Venue,
+			VenueCity:     next.Venuecity,
+			KickOffTime:   next.Kickofftime.Time.UTC(),
+		}
+		headToHead.AtVenue = &models.APIHeadToHeadRecord{}
+	}
+
+	for _, r := range results {
+		result := models.APIHeadToHeadResult{
+			FixtureID:     r.FixtureID,
+			CompetitionID: r.CompetitionID,
+			Season:        r.Season,
+			RoundTitle:    r.Roundtitle,
+			Venue:         r.Venue,
+			VenueCity:     r.Venuecity,
+			KickOffTime:   r.Kickofftime.Time.UTC(),
+			Home:          r.HometeamID == teamId,
+			Score:         *r.HometeamScore,
+			OpponentScore: *r.AwayteamScore,
+		}
+		if !result.Home {
+			result.Score, result.OpponentScore = result.OpponentScore, result.Score
+		}
+
+		switch {
+		case result.Score > result.OpponentScore:
+			result.Result = config.FormWin
+		case result.Score < result.OpponentScore:
+			result.Result = config.FormLoss
+		default:
+			result.Result = config.FormDraw
+		}
+
+		addHeadToHeadResult(&headToHead.Overall, result)
+		if next != nil && next.VenueID != nil && r.VenueID != nil && *next.VenueID == *r.VenueID {
+			addHeadToHeadResult(headToHead.AtVenue, result)
+		}
+
+		if len(headToHead.LastMeetings) < config.HeadToHeadMatches {
+			headToHead.LastMeetings = append(headToHead.LastMeetings, result)
+		}
+	}
+
+	// Round the average margins to one decimal place
+	headToHead.Overall.AverageMargin = roundTo(headToHead.Overall.AverageMargin, 1)
+	if headToHead.AtVenue != nil {
+		headToHead.AtVenue.AverageMargin = roundTo(headToHead.AtVenue.AverageMargin, 1)
+	}
+
+	return headToHead, nil
+}
+
+// addHeadToHeadResult adds a meeting to a head-to-head record, keeping a running
+// average of the margin.
+func addHeadToHeadResult(record *models.APIHeadToHeadRecord, result models.APIHeadToHeadResult) {
+	record.Played++
+
+	switch result.Result {
+	case config.FormWin:
+		record.Wins++
+	case config.FormLoss:
+		record.OpponentWins++
+	default:
+		record.Draws++
+	}
+
+	margin := float64(result.Score - result.OpponentScore)
+	record.AverageMargin += (margin - record.AverageMargin) / float64(record.Played)
+}
+
+// GetCompetitionSeries fetches the games of a series competition season (e.g.
+// State of Origin) and calculates the state of the series. The shield holder is
+// carried through the earlier seasons, as the previous holder keeps the shield
+// until a series is won. If season is nil, the latest season of the competition
+// is used. Returns nil if the competition is not played as a series or the
+// season has no games.
+func (s *APIDataService) GetCompetitionSeries(competitionId int64, season *int32) (*models.APISeries, error) {
+	if !utils.IsSeries(int(competitionId)) {
+		return nil, nil
+	}
+
+	games, err := s.queries.ListSeriesFixturesByCompetitionID(s.ctx, db.ListSeriesFixturesByCompetitionIDParams{
+		CompetitionID: competitionId,
+		Season:        season,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(games) == 0 || (season != nil && games[len(games)-1].Season != *season) {
+		return nil, nil
+	}
+
+	// Replay each season in order, handing the shield to the winner of each series.
+	var series models.APISeries
+	var holder *int64
+	for start := 0; start < len(games); {
+		end := start
+		for end < len(games) && games[end].Season == games[start].Season {
+			end++
+		}
+
+		series = newAPISeries(competitionId, games[start:end], holder)
+		holder = series.ShieldHolderTeamID
+		start = end
+	}
+
+	return &series, nil
+}
+
+// newAPISeries calculates the state of a series from the games of a season, in
+// order of kickoff, and the team holding the shield before the series.
+func newAPISeries(competitionId int64, games []*db.ListSeriesFixturesByCompetitionIDRow, previousHolder *int64) models.APISeries {
+	series := models.APISeries{
+		CompetitionID:      competitionId,
+		Season:             games[0].Season,
+		ShieldHolderTeamID: previousHolder,
+		Teams:              make([]models.APISeriesTeam, 0, 2),
+		Games:              make([]models.APISeriesGame, 0, len(games)),
+	}
+
+	// Teams are listed in the order they first appear until sorted by wins.
+	teamIndex := func(teamId int64, nickname string) int {
+		for i, team := range series.Teams {
+			if team.TeamID == teamId {
+				return i
+			}
+		}
+		series.Teams = append(series.Teams, models.APISeriesTeam{TeamID: teamId, Nickname: nickname})
+		return len(series.Teams) - 1
+	}
+
+	for _, g := range games {
+		home := teamIndex(g.HometeamID, g.HometeamNickname)
+		away := teamIndex(g.AwayteamID, g.AwayteamNickname)
+
+		game := models.APISeriesGame{
+			FixtureID:   g.FixtureID,
+			RoundTitle:  g.Roundtitle,
+			MatchState:  g.Matchstate,
+			KickOffTime: g.Kickofftime.Time.UTC(),
+			HomeTeamID:  g.HometeamID,
+			AwayTeamID:  g.AwayteamID,
+			HomeScore:   g.HometeamScore,
+			AwayScore:   g.AwayteamScore,
+		}
+
+		if g.Matchstate != config.MatchStateFullTime || g.HometeamScore == nil || g.AwayteamScore == nil {
+			series.GamesRemaining++
+			series.Games = append(series.Games, game)
+			continue
+		}
+
+		series.GamesPlayed++
+		switch {
+		case *g.HometeamScore > *g.AwayteamScore:
+			series.Teams[home].Wins++
+			game.WinnerTeamID = &g.HometeamID
+		case *g.HometeamScore < *g.AwayteamScore:
+			series.Teams[away].Wins++
+			game.WinnerTeamID = &g.AwayteamID
+		default:
+			series.Draws++
+		}
+		series.Games = append(series.Games, game)
+	}
+
+	slices.SortStableFunc(series.Teams, func(a, b models.APISeriesTeam) int {
+		return cmp.Compare(b.Wins, a.Wins)
+	})
+
+	var leaderWins, trailerWins int32
+	if len(series.Teams) > 0 {
+		leaderWins = series.Teams[0].Wins
+	}
+	if len(series.Teams) > 1 {
+		trailerWins = series.Teams[1].Wins
+	}
+	series.Score = fmt.Sprintf("%d-%d", leaderWins, trailerWins)
+
+	// The series is decided once the trailing team can no longer catch up.
+	series.Decided = series.GamesRemaining == 0 || leaderWins > trailerWins+series.GamesRemaining
+	if series.Decided && leaderWins > trailerWins {
+		winner := series.Teams[0].TeamID
+		series.WinnerTeamID = &winner
+		series.ShieldHolderTeamID = &winner
+	}
+	series.ShieldRetained = series.Decided && previousHolder != nil &&
+		series.ShieldHolderTeamID != nil && *series.ShieldHolderTeamID == *previousHolder
+
+	return series
+}
+
+// newAPITeamDetails converts a team and its competition seasons, ordered by competition, to an API model.
+func newAPITeamDetails(team db.Team, memberships []*db.TeamCompetition) models.APITeamDetails {
+	apiTeam := models.APITeamDetails{
+		ID:              team.ID,
+		Nickname:        team.Nickname,
+		Name:            team.Name,
+		Code:            team.Code,
+		PrimaryColour:   team.PrimaryColour,
+		SecondaryColour: team.SecondaryColour,
+		LogoURL:         team.LogoUrl,
+		Competitions:    make([]models.APITeamCompetition, 0),
+	}
+
+	for _, m := range memberships {
+		last := len(apiTeam.Competitions) - 1
+		if last < 0 || apiTeam.Competitions[last].CompetitionID != m.CompetitionID {
+			apiTeam.Competitions = append(apiTeam.Competitions, models.APITeamCompetition{
+				CompetitionID: m.CompetitionID,
+			})
+			last++
+		}
+		apiTeam.Competitions[last].Seasons = append(apiTeam.Competitions[last].Seasons, m.Season)
+	}
+
+	return apiTeam
+}
+
+// apiFixtureTeam returns the home or away team of an API fixture matching the team ID.
+func apiFixtureTeam(fixture *models.APIFixture, homeTeamID, teamID int64) *models.APITeam {
+	if teamID == homeTeamID {
+		return &fixture.HomeTeam
+	}
+	return &fixture.AwayTeam
+}
+
+// newAPIPlayer converts a player and the number and position they are named in to an API model.
+func newAPIPlayer(player db.Player, number int32, position string) models.APIPlayer {
+	return models.APIPlayer{
+		ID:       player.ID,
+		Name:     player.FirstName + " " + player.LastName,
+		Number:   number,
+		Position: position,
+	}
+}
+
+// addFixtureContext adds what is calculated across fixtures to each fixture: the
+// form of the teams, the prediction from the team ratings and the drift of the
+// betting market.
+func (s *APIDataService) addFixtureContext(fixtures []models.APIFixture) error {
+	if err := s.addTeamForms(fixtures); err != nil {
+		return err
+	}
+
+	if err := s.addPredictions(fixtures); err != nil {
+		return err
+	}
+
+	return s.addMarketDrift(fixtures)
+}
+
+// teamFormKey identifies the form of a team in a competition before a kickoff.
+type teamFormKey struct {
+	competitionId int64
+	teamId        int64
+	kickOff       int64
+}
+
+// addTeamForms adds the recent form of both teams to each fixture, calculated from
+// the last results stored for each team in the competition before the fixture
+// kicked off.
+func (s *APIDataService) addTeamForms(fixtures []models.APIFixture) error {
+	// Fetch the last results of each team once for every kickoff they need
+	params := db.ListTeamFormResultsParams{Matches: config.FormMatches}
+	seen := make(map[teamFormKey]bool)
+	for _, f := range fixtures {
+		for _, teamId := range []int64{f.HomeTeam.ID, f.AwayTeam.ID} {
+			key := teamFormKey{f.CompetitionID, teamId, f.KickOffTime.UnixMicro()}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			params.CompetitionIds = append(params.CompetitionIds, f.CompetitionID)
+			params.TeamIds = append(params.TeamIds, teamId)
+			params.KickOffsBefore = append(params.KickOffsBefore, pgtype.Timestamptz{Time: f.KickOffTime, Valid: true})
+		}
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+
+	rows, err := s.queries.ListTeamFormResults(s.ctx, params)
+	if err != nil {
+		return err
+	}
+
+	results := make(map[teamFormKey][]*db.ListTeamFormResultsRow)
+	for _, r := range rows {
+		key := teamFormKey{r.CompetitionID, r.TeamID, r.KickOffBefore.Time.UnixMicro()}
+		results[key] = append(results[key], r)
+	}
+
+	for i := range fixtures {
+		f := &fixtures[i]
+		kickOff := f.KickOffTime.UnixMicro()
+		f.HomeTeam.FormDetail = newAPITeamForm(f.HomeTeam.ID, f.KickOffTime, results[teamFormKey{f.CompetitionID, f.HomeTeam.ID, kickOff}])
+		f.AwayTeam.FormDetail = newAPITeamForm(f.AwayTeam.ID, f.KickOffTime, results[teamFormKey{f.CompetitionID, f.AwayTeam.ID, kickOff}])
+	}
+
+	return nil
+}
+
+// addPredictions adds the prediction of each fixture from the team ratings, if
+// the ratings have been calculated since it was stored.
+func (s *APIDataService) addPredictions(fixtures []models.APIFixture) error {
+	fixtureIds := make([]int64, 0, len(fixtures))
+	for _, f := range fixtures {
+		fixtureIds = append(fixtureIds, f.ID)
+	}
+
+	predictions, err := s.queries.ListFixturePredictionsByFixtureIDs(s.ctx, fixtureIds)
+	if err != nil {
+		return err
+	}
+
+	fixturePredictions := make(map[int64]*db.FixturePrediction)
+	for _, p := range predictions {
+		fixturePredictions[p.FixtureID] = p
+	}
+
+	for i := range fixtures {
+		if p, ok := fixturePredictions[fixtures[i].ID]; ok {
+			fixtures[i].Prediction = &models.APIPrediction{
+				HomeRating:         roundTo(p.HomeRating, 1),
+				AwayRating:         roundTo(p.AwayRating, 1),
+				HomeWinProbability: roundTo(p.HomeWinProbability, 3),
+				AwayWinProbability: roundTo(1-p.HomeWinProbability, 3),
+				PredictedMargin:    roundTo(p.PredictedMargin, 1),
+			}
+		}
+	}
+
+	return nil
+}
+
+// addMarketDrift adds the movement of the betting market since the odds of each
+// fixture were first recorded.
+func (s *APIDataService) addMarketDrift(fixtures []models.APIFixture) error {
+	fixtureIds := make([]int64, 0, len(fixtures))
+	for _, f := range fixtures {
+		if f.Market != nil {
+			fixtureIds = append(fixtureIds, f.ID)
+		}
+	}
+	if len(fixtureIds) == 0 {
+		return nil
+	}
+
+	openingOdds, err := s.queries.ListOpeningOddsByFixtureIDs(s.ctx, fixtureIds)
+	if err != nil {
+		return err
+	}
+
+	fixtureOpeningOdds := make(map[int64]*db.OddsHistory)
+	for _, o := range openingOdds {
+		fixtureOpeningOdds[o.FixtureID] = o
+	}
+
+	for i := range fixtures {
+		market := fixtures[i].Market
+		if o, ok := fixtureOpeningOdds[fixtures[i].ID]; ok && market != nil {
+			opening := roundTo(utils.ImpliedProbability(o.HometeamOdds, o.AwayteamOdds), 3)
+			drift := roundTo(market.HomeProbability-opening, 3)
+			market.OpeningHomeProbability = &opening
+			market.HomeDrift = &drift
+		}
+	}
+
+	return nil
+}
+
+// newAPIMarket converts the odds of both teams of a match into implied
+// probabilities, with the bookmaker margin removed. Nil is returned until both
+// teams have odds.
+func newAPIMarket(homeTeamId, awayTeamId int64, homeOdds, awayOdds *float64) *models.APIMarket {
+	if homeOdds == nil || awayOdds == nil || *homeOdds <= 0 || *awayOdds <= 0 {
+		return nil
+	}
+
+	homeProbability := utils.ImpliedProbability(*homeOdds, *awayOdds)
+	market := &models.APIMarket{
+		HomeProbability: roundTo(homeProbability, 3),
+		AwayProbability: roundTo(1-homeProbability, 3),
+		Overround:       roundTo(1 / *homeOdds + 1 / *awayOdds - 1, 3),
+		CloseGame:       max(homeProbability, 1-homeProbability) < config.CloseGameProbability,
+	}
+
+	if homeProbability > 0.5 {
+		market.FavouriteTeamID, market.UnderdogTeamID = &homeTeamId, &awayTeamId
+	} else if homeProbability < 0.5 {
+		market.FavouriteTeamID, market.UnderdogTeamID = &awayTeamId, &homeTeamId
+	}
+
+	return market
+}
+
+// newAPITeamForm calculates the form of a team from its last results before a
+// kickoff time. Results must be ordered newest first.
+func newAPITeamForm(teamId int64, kickOffTime time.Time, results []*db.ListTeamFormResultsRow) *models.APITeamForm {
+	form := &models.APITeamForm{
+		Results: make([]models.APIFormResult, 0, config.FormMatches),
+	}
+
+	for _, r := range results {
+		if len(form.Results) == config.FormMatches {
+			break
+		}
+		if !r.Kickofftime.Time.Before(kickOffTime) {
+			continue
+		}
+
+		result := models.APIFormResult{
+			FixtureID:   r.FixtureID,
+			KickOffTime: r.Kickofftime.Time.UTC(),
+		}
+		switch teamId {
+		case r.HometeamID:
+			result.Home = true
+			result.OpponentID, result.Opponent = r.AwayteamID, r.AwayteamNickname
+			result.Score, result.OpponentScore = *r.HometeamScore, *r.AwayteamScore
+		case r.AwayteamID:
+			result.OpponentID, result.Opponent = r.HometeamID, r.HometeamNickname
+			result.Score, result.OpponentScore = *r.AwayteamScore, *r.HometeamScore
+		default:
+			continue
+		}
+
+		split := &form.Away
+		if result.Home {
+			split = &form.Home
+		}
+		split.Played++
+		split.PointsFor += result.Score
+		split.PointsAgainst += result.OpponentScore
+
+		switch {
+		case result.Score > result.OpponentScore:
+			result.Result = config.FormWin
+			form.Wins++
+			split.Wins++
+		case result.Score < result.OpponentScore:
+			result.Result = config.FormLoss
+			form.Losses++
+			split.Losses++
+		default:
+			result.Result = config.FormDraw
+			form.Draws++
+			split.Draws++
+		}
+		form.PointsFor += result.Score
+		form.PointsAgainst += result.OpponentScore
+
+		form.Results = append(form.Results, result)
+	}
+
+	return form
+}
+
+// newAPIFixture converts a fixture, its match details, teams, round points weight
+// and venue timezone to an API model.
+func newAPIFixture(matchDetail db.MatchDetail, fixture db.Fixture, homeTeam, awayTeam db.Team, pointsWeight int32, venueTimezone *string) models.APIFixture {
+	// Fixtures at venues without a known location are given in UTC
+	timezone := "UTC"
+	if venueTimezone != nil {
+		timezone = *venueTimezone
+	}
+
+	location, err := utils.LoadLocation(timezone)
+	if err != nil {
+		log.Printf("Unknown timezone %s for venue %s: %v", timezone, fixture.Venue, err)
+		timezone, location = "UTC", time.UTC
+	}
+
+	return models.APIFixture{
+		ID:            fixture.ID,
+		CompetitionID: fixture.CompetitionID,
+		Season:        fixture.Season,
+		RoundNumber:   fixture.RoundNumber,
+		RoundTitle:    fixture.Roundtitle,
+		PointsWeight:  pointsWeight,
+		MatchState:    fixture.Matchstate,
+		Venue:         fixture.Venue,
+		VenueCity:     fixture.Venuecity,
 		HomeTeam: models.APITeam{
-			Nickname: fixture.Team.Nickname,
-			Score:    fixture.MatchDetail.HometeamScore,
-			Odds:     fixture.MatchDetail.HometeamOdds,
-			Form:     fixture.MatchDetail.HometeamForm,
+			ID:       homeTeam.ID,
+			Nickname: homeTeam.Nickname,
+			Score:    matchDetail.HometeamScore,
+			Odds:     matchDetail.HometeamOdds,
+			Form:     matchDetail.HometeamForm,
 		},
 		AwayTeam: models.APITeam{
-			Nickname: fixture.Team_2.Nickname,
-			Score:    fixture.MatchDetail.AwayteamScore,
-			Odds:     fixture.MatchDetail.AwayteamOdds,
-			Form:     fixture.MatchDetail.AwayteamForm,
+			ID:       awayTeam.ID,
+			Nickname: awayTeam.Nickname,
+			Score:    matchDetail.AwayteamScore,
+			Odds:     matchDetail.AwayteamOdds,
+			Form:     matchDetail.AwayteamForm,
 		},
-		KickOffTime: fixture.Fixture.Kickofftime.Time,
+		Market:           newAPIMarket(homeTeam.ID, awayTeam.ID, matchDetail.HometeamOdds, matchDetail.AwayteamOdds),
+		VenueTimezone:    timezone,
+		KickOffTime:      fixture.Kickofftime.Time.UTC(),
+		LocalKickOffTime: fixture.Kickofftime.Time.In(location),
 	}
-
-	return &apiFixture, nil
 }
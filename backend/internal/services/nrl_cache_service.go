@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+	"github.com/aussiebroadwan/tipping/backend/internal/models"
+)
+
+// NRLCacheService persists NRL API responses in the database so that unchanged
+// documents can be detected across fetches and restarts. Changed responses are
+// held until they are committed, so a document is only cached once what it
+// contains has been stored.
+type NRLCacheService struct {
+	queries *db.Queries
+	ctx     context.Context
+	hits    atomic.Int64
+	misses  atomic.Int64
+	pending map[string]db.UpsertNRLResponseCacheParams
+	mu      sync.Mutex
+}
+
+// NewNRLCacheService creates a new instance of NRLCacheService.
+func NewNRLCacheService(queries *db.Queries, ctx context.Context) *NRLCacheService {
+	return &NRLCacheService{
+		queries: queries,
+		ctx:     ctx,
+		pending: make(map[string]db.UpsertNRLResponseCacheParams),
+	}
+}
+
+// Get returns the cached response for a URL, or nil if it has not been cached.
+func (s *NRLCacheService) Get(url string) *db.NrlResponseCache {
+	cached, err := s.queries.GetNRLResponseCache(s.ctx, url)
+	if err != nil {
+		return nil
+	}
+	return cached
+}
+
+// NotModified records a fetch where the server confirmed the cached response
+// is still current.
+func (s *NRLCacheService) NotModified(url string) {
+	s.hits.Add(1)
+	if err := s.queries.TouchNRLResponseCache(s.ctx, url); err != nil {
+		log.Printf("Error updating NRL response cache for %s: %v", url, err)
+	}
+}
+
+// Store compares a freshly fetched body against the cached response loaded
+// before the fetch, and holds it until Commit is called for the URL. It returns
+// true if the body differs from what was previously cached.
+func (s *NRLCacheService) Store(url, etag, lastModified string, body []byte, cached *db.NrlResponseCache) bool {
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+
+	if cached != nil && cached.ContentHash == hash {
+		s.NotModified(url)
+		return false
+	}
+	s.misses.Add(1)
+
+	s.mu.Lock()
+	s.pending[url] = db.UpsertNRLResponseCacheParams{
+		Url:          url,
+		Etag:         optionalHeader(etag),
+		LastModified: optionalHeader(lastModified),
+		ContentHash:  hash,
+		Body:         body,
+	}
+	s.mu.Unlock()
+
+	return true
+}
+
+// Commit saves the response held for a URL by Store, once what it contains has
+// been stored. URLs without a held response are ignored.
+func (s *NRLCacheService) Commit(url string) {
+	s.mu.Lock()
+	params, ok := s.pending[url]
+	delete(s.pending, url)
+	s.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if _, err := s.queries.UpsertNRLResponseCache(s.ctx, params); err != nil {
+		log.Printf("Error storing NRL response cache for %s: %v", url, err)
+	}
+}
+
+// Invalidate removes the cached response for a URL so it is treated as
+// changed on the next fetch.
+func (s *NRLCacheService) Invalidate(url string) {
+	s.mu.Lock()
+	delete(s.pending, url)
+	s.mu.Unlock()
+
+	if err := s.queries.DeleteNRLResponseCache(s.ctx, url); err != nil {
+		log.Printf("Error invalidating NRL response cache for %s: %v", url, err)
+	}
+}
+
+// Stats returns the cache hit and miss counts since the service started.
+func (s *NRLCacheService) Stats() models.APICacheStats {
+	hits, misses := s.hits.Load(), s.misses.Load()
+
+	stats := models.APICacheStats{
+		Requests: hits + misses,
+		Hits:     hits,
+		Misses:   misses,
+	}
+	if stats.Requests > 0 {
+		stats.HitRate = float64(hits) / float64(stats.Requests)
+	}
+
+	return stats
+}
+
+func optionalHeader(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -1,8 +1,11 @@
 package services
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/aussiebroadwan/tipping/backend/internal/db"
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
 	"github.com/aussiebroadwan/tipping/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -35,8 +39,12 @@ func (s *NRLDataService) StoreFixtureAndDetails(fixture models.NRLFixture) error
 		return fmt.Errorf("failed to parse fixture ID: %w", err)
 	}
 
-	// Parse match ID components
-	_, compID, _, _ := utils.ParseMatchID(fixture.ID)
+	// Parse match ID components, preferring the round number in the title as
+	// that is the round the fixture is shown and looked up as
+	season, compID, roundNumber, _ := utils.ParseMatchID(fixture.ID)
+	if number, ok := utils.ParseRoundNumber(fixture.RoundTitle); ok {
+		roundNumber = number
+	}
 
 	// Parse kickoff time
 	kickOffTime, err := time.Parse(time.RFC3339, fixture.KickOffTime)
@@ -44,40 +52,263 @@ func (s *NRLDataService) StoreFixtureAndDetails(fixture models.NRLFixture) error
 		return fmt.Errorf("failed to parse fixture kickoff time: %w", err)
 	}
 
+	// Keep the stored result, so corrections to a completed fixture can be detected
+	stored, _ := s.queries.GetMatchDetailsByFixtureID(s.ctx, fixtureID)
+
 	// Create fixture in the database
-	err = s.createOrUpdateFixture(fixtureID, compID, fixture, kickOffTime)
+	err = s.createOrUpdateFixture(fixtureID, compID, season, roundNumber, fixture, kickOffTime)
 	if err != nil {
 		return err
 	}
 
-	// Update Competition with current round
+	// Store the round the fixture belongs to
+	if err := s.storeRound(compID, season, roundNumber, fixture.RoundTitle); err != nil {
+		return fmt.Errorf("failed to store round: %w", err)
+	}
+
 	if fixture.IsCurrentRound {
-		// Update Competition with current round
-		_, err = s.queries.UpdateCompetitionRound(s.ctx, db.UpdateCompetitionRoundParams{
-			ID:    int64(compID),
-			Round: &fixture.RoundTitle,
+		// Update the competition season with the current round
+		_, err = s.queries.UpsertCompetitionSeasonRound(s.ctx, db.UpsertCompetitionSeasonRoundParams{
+			CompetitionID: int64(compID),
+			Season:        int32(season),
+			Round:         &fixture.RoundTitle,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to update competition round: %w", err)
 		}
 	}
 
-	// Store each team
-	if err := s.storeTeam(fixture.HomeTeam, compID); err != nil {
+	// Store each team, with their full details if the match centre has been fetched
+	var homeTeamList, awayTeamList *models.NRLTeamList
+	if fixture.MatchCentre != nil {
+		homeTeamList, awayTeamList = &fixture.MatchCentre.HomeTeam, &fixture.MatchCentre.AwayTeam
+	}
+	if err := s.storeTeam(fixture.HomeTeam, homeTeamList, compID, season); err != nil {
 		return fmt.Errorf("failed to store home team: %w", err)
 	}
-	if err := s.storeTeam(fixture.AwayTeam, compID); err != nil {
+	if err := s.storeTeam(fixture.AwayTeam, awayTeamList, compID, season); err != nil {
 		return fmt.Errorf("failed to store away team: %w", err)
 	}
 
 	// Store match details
-	if err := s.storeMatchDetails(fixtureID, fixture); err != nil {
+	if err := s.storeMatchDetails(fixtureID, fixture, stored); err != nil {
 		return fmt.Errorf("failed to store match details: %w", err)
 	}
 
+	// Store the team lists, if the match centre has been fetched
+	if fixture.MatchCentre != nil {
+		started := !time.Now().Before(kickOffTime)
+		if err := s.storeTeamList(fixtureID, int64(fixture.HomeTeam.ID), fixture.MatchCentre.HomeTeam, started); err != nil {
+			return fmt.Errorf("failed to store home team list: %w", err)
+		}
+		if err := s.storeTeamList(fixtureID, int64(fixture.AwayTeam.ID), fixture.MatchCentre.AwayTeam, started); err != nil {
+			return fmt.Errorf("failed to store away team list: %w", err)
+		}
+	}
+
+	// Store the timeline and team statistics once the match is over
+	if fixture.MatchCentre != nil && fixture.MatchState == config.MatchStateFullTime {
+		if err := s.storeMatchStats(fixtureID, fixture); err != nil {
+			return fmt.Errorf("failed to store match stats: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// StoreRoundByes replaces the teams on the bye for the round of a draw. The round
+// must already have been stored along with the fixtures of the draw.
+func (s *NRLDataService) StoreRoundByes(competitionID int64, draw models.NRLDraw) error {
+	season, round := int32(draw.SelectedSeasonID), int32(draw.SelectedRoundID)
+
+	err := s.queries.DeleteRoundByes(s.ctx, db.DeleteRoundByesParams{
+		CompetitionID: competitionID,
+		Season:        season,
+		RoundNumber:   round,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clear round byes: %w", err)
+	}
+
+	for _, bye := range draw.Byes {
+		// Byes may only be listed by nickname, so find the team it refers to
+		teamID := int64(bye.TeamID)
+		if teamID == 0 {
+			team, err := s.queries.GetTeamByNickname(s.ctx, db.GetTeamByNicknameParams{
+				Nickname:      bye.Name,
+				CompetitionID: competitionID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to find bye team %s: %w", bye.Name, err)
+			}
+			teamID = team.ID
+		}
+
+		if err := s.storeTeam(models.NRLTeam{ID: int(teamID), Name: bye.Name}, nil, int(competitionID), int(season)); err != nil {
+			return fmt.Errorf("failed to store bye team: %w", err)
+		}
+
+		err := s.queries.CreateRoundBye(s.ctx, db.CreateRoundByeParams{
+			CompetitionID: competitionID,
+			Season:        season,
+			RoundNumber:   round,
+			TeamID:        teamID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to store round bye: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// StoreLadder stores each position of a competition ladder. Ladder positions only
+// list team nicknames, so the teams must already have been stored with fixtures.
+func (s *NRLDataService) StoreLadder(competitionID int64, ladder models.NRLLadder) error {
+	for i, position := range ladder.Positions {
+		team, err := s.queries.GetTeamByNickname(s.ctx, db.GetTeamByNicknameParams{
+			Nickname:      position.TeamNickname,
+			CompetitionID: competitionID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to find ladder team %s: %w", position.TeamNickname, err)
+		}
+
+		_, err = s.queries.UpsertLadderEntry(s.ctx, db.UpsertLadderEntryParams{
+			CompetitionID:    competitionID,
+			Season:           int32(ladder.SelectedSeasonID),
+			RoundNumber:      int32(ladder.SelectedRoundID),
+			TeamID:           team.ID,
+			Position:         int32(i + 1),
+			Played:           int32(position.Stats.Played),
+			Wins:             int32(position.Stats.Wins),
+			Draws:            int32(position.Stats.Drawn),
+			Losses:           int32(position.Stats.Lost),
+			Byes:             int32(position.Stats.Byes),
+			PointsFor:        int32(position.Stats.PointsFor),
+			PointsAgainst:    int32(position.Stats.PointsAgainst),
+			PointsDifference: int32(position.Stats.PointsDifference),
+			Points:           int32(position.Stats.Points),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to store ladder entry for %s: %w", position.TeamNickname, err)
+		}
+	}
+
+	return nil
+}
+
+// CheckLadder recalculates the ladder of a competition as at a round from the stored
+// results and byes, and compares it against the stored ladder. It returns a description
+// of each difference found, which will be empty if the ladder is consistent.
+func (s *NRLDataService) CheckLadder(competitionID int64, season, round int32) ([]string, error) {
+	entries, err := s.queries.ListLadderEntriesByCompetitionID(s.ctx, db.ListLadderEntriesByCompetitionIDParams{
+		CompetitionID: competitionID,
+		Season:        &season,
+		Round:         &round,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ladder entries: %w", err)
+	}
+
+	results, err := s.queries.ListLadderResultsByCompetitionID(s.ctx, db.ListLadderResultsByCompetitionIDParams{
+		CompetitionID: competitionID,
+		Season:        season,
+		Round:         round,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ladder results: %w", err)
+	}
+
+	byes, err := s.queries.ListLadderByesByCompetitionID(s.ctx, db.ListLadderByesByCompetitionIDParams{
+		CompetitionID: competitionID,
+		Season:        season,
+		Round:         round,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ladder byes: %w", err)
+	}
+
+	// Recalculate each team's entry from the results and byes
+	computed := make(map[int64]*db.LadderEntry)
+	entryFor := func(teamID int64) *db.LadderEntry {
+		if _, ok := computed[teamID]; !ok {
+			computed[teamID] = &db.LadderEntry{TeamID: teamID}
+		}
+		return computed[teamID]
+	}
+
+	for _, r := range results {
+		home, away := entryFor(r.HometeamID), entryFor(r.AwayteamID)
+		addLadderResult(home, *r.HometeamScore, *r.AwayteamScore)
+		addLadderResult(away, *r.AwayteamScore, *r.HometeamScore)
+	}
+
+	for _, b := range byes {
+		entry := entryFor(b.TeamID)
+		entry.Byes += b.Byes
+		entry.Points += b.Byes * config.LadderPointsBye
+	}
+
+	var discrepancies []string
+	for _, e := range entries {
+		stored := e.LadderEntry
+		expected := entryFor(stored.TeamID)
+
+		fields := []struct {
+			name             string
+			stored, computed int32
+		}{
+			{"played", stored.Played, expected.Played},
+			{"wins", stored.Wins, expected.Wins},
+			{"draws", stored.Draws, expected.Draws},
+			{"losses", stored.Losses, expected.Losses},
+			{"byes", stored.Byes, expected.Byes},
+			{"points for", stored.PointsFor, expected.PointsFor},
+			{"points against", stored.PointsAgainst, expected.PointsAgainst},
+			{"points", stored.Points, expected.Points},
+		}
+		for _, f := range fields {
+			if f.stored != f.computed {
+				discrepancies = append(discrepancies, fmt.Sprintf("%s %s is %d on the ladder but %d from results", e.Team.Nickname, f.name, f.stored, f.computed))
+			}
+		}
+	}
+
+	return discrepancies, nil
+}
+
+// RefreshRoundPointsWeights sets the points weight of every stored round from
+// its type and title, so rounds stored before the weights in config changed are
+// weighted the same as new rounds. It returns the number of rounds updated.
+func (s *NRLDataService) RefreshRoundPointsWeights() (int, error) {
+	rounds, err := s.queries.ListAllRounds(s.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list rounds: %w", err)
+	}
+
+	updated := 0
+	for _, round := range rounds {
+		weight := int32(utils.RoundPointsWeight(round.Type, round.Title))
+		if round.PointsWeight == weight {
+			continue
+		}
+
+		err := s.queries.UpdateRoundPointsWeight(s.ctx, db.UpdateRoundPointsWeightParams{
+			CompetitionID: round.CompetitionID,
+			Season:        round.Season,
+			Number:        round.Number,
+			PointsWeight:  weight,
+		})
+		if err != nil {
+			return updated, fmt.Errorf("failed to update round points weight: %w", err)
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
 func (s *NRLDataService) UpdateMatchState(fixtureID string, matchState string) error {
 	// Parse fixture ID
 	id, err := strconv.ParseInt(fixtureID, 10, 64)
@@ -95,6 +326,8 @@ func (s *NRLDataService) UpdateMatchState(fixtureID string, matchState string) e
 	return nil
 }
 
+// UpdateMatchScores stores the final scores and winner of a completed fixture,
+// recording a result correction if it was already complete with other scores.
 func (s *NRLDataService) UpdateMatchScores(fixtureID string, homeId int, homeScore *int, awayId int, awayScore *int) error {
 	// Parse fixture ID
 	id, err := strconv.ParseInt(fixtureID, 10, 64)
@@ -106,19 +339,26 @@ func (s *NRLDataService) UpdateMatchScores(fixtureID string, homeId int, homeSco
 		return fmt.Errorf("home and away scores are required")
 	}
 
-	homeScore32 := int32(*homeScore)
-	awayScore32 := int32(*awayScore)
+	fixture := models.NRLFixture{
+		ID:         fixtureID,
+		MatchState: config.MatchStateFullTime,
+		HomeTeam:   models.NRLTeam{ID: homeId, Score: homeScore},
+		AwayTeam:   models.NRLTeam{ID: awayId, Score: awayScore},
+	}
 
-	winnerId := int64(homeId)
-	if *homeScore < *awayScore {
-		winnerId = int64(awayId)
+	stored, err := s.queries.GetMatchDetailsByFixtureID(s.ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get match details: %w", err)
+	}
+	if err := s.storeResultCorrection(id, fixture, stored); err != nil {
+		return err
 	}
 
 	_, err = s.queries.UpdateMatchDetail(s.ctx, db.UpdateMatchDetailParams{
 		FixtureID:     id,
-		HomeTeamScore: &homeScore32,
-		AwayTeamScore: &awayScore32,
-		WinnerTeamId:  &winnerId,
+		HomeTeamScore: parseScore(fixture.HomeTeam.Score),
+		AwayTeamScore: parseScore(fixture.AwayTeam.Score),
+		WinnerTeamId:  parseWinnerTeamID(fixture),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update match scores: %w", err)
@@ -127,16 +367,25 @@ func (s *NRLDataService) UpdateMatchScores(fixtureID string, homeId int, homeSco
 }
 
 // createOrUpdateFixture creates or updates a fixture in the database.
-func (s *NRLDataService) createOrUpdateFixture(fixtureID int64, compID int, fixture models.NRLFixture, kickOffTime time.Time) error {
-	pgxKickOffTime := pgtype.Timestamp{Time: kickOffTime, Valid: true}
+func (s *NRLDataService) createOrUpdateFixture(fixtureID int64, compID, season, roundNumber int, fixture models.NRLFixture, kickOffTime time.Time) error {
+	pgxKickOffTime := pgtype.Timestamptz{Time: kickOffTime, Valid: true}
+
+	venueID, err := s.storeVenue(fixture.Venue, fixture.VenueCity)
+	if err != nil {
+		return err
+	}
 
 	// Check if fixture exists
 	checkFixture, _ := s.queries.GetFixtureByID(s.ctx, fixtureID)
 	if checkFixture.ID == fixtureID {
-		// Update fixture
+		// Update fixture, including the kickoff time and venue of rescheduled fixtures
 		_, err := s.queries.UpdateFixture(s.ctx, db.UpdateFixtureParams{
-			ID:         fixtureID,
-			MatchState: &fixture.MatchState,
+			ID:          fixtureID,
+			MatchState:  &fixture.MatchState,
+			KickOffTime: pgxKickOffTime,
+			Venue:       &fixture.Venue,
+			VenueCity:   &fixture.VenueCity,
+			VenueID:     &venueID,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to update fixture: %w", err)
@@ -144,13 +393,16 @@ func (s *NRLDataService) createOrUpdateFixture(fixtureID int64, compID int, fixt
 		return nil
 	}
 
-	_, err := s.queries.CreateFixture(s.ctx, db.CreateFixtureParams{
+	_, err = s.queries.CreateFixture(s.ctx, db.CreateFixtureParams{
 		ID:             fixtureID,
 		CompetitionID:  int64(compID),
+		Season:         int32(season),
+		RoundNumber:    int32(roundNumber),
 		Roundtitle:     fixture.RoundTitle,
 		Matchstate:     fixture.MatchState,
 		Venue:          fixture.Venue,
 		Venuecity:      fixture.VenueCity,
+		VenueID:        &venueID,
 		Matchcentreurl: fixture.MatchCentreURL,
 		Kickofftime:    pgxKickOffTime,
 	})
@@ -161,32 +413,330 @@ func (s *NRLDataService) createOrUpdateFixture(fixtureID int64, compID int, fixt
 	return nil
 }
 
-// storeTeam stores a team in the database, creating it if it does not exist.
-func (s *NRLDataService) storeTeam(team models.NRLTeam, competitionId int) error {
-	// Check if team exists
-	checkTeam, _ := s.queries.GetTeamByID(s.ctx, int64(team.ID))
-	if checkTeam.ID == int64(team.ID) {
-		return nil
+// RefreshVenues applies the locations in config to every stored venue, and
+// creates and links the venues of fixtures which are not linked to one. It
+// returns the number of fixtures linked.
+func (s *NRLDataService) RefreshVenues() (int64, error) {
+	venues, err := s.queries.ListVenues(s.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list venues: %w", err)
 	}
 
-	// Create team
-	_, err := s.queries.CreateTeam(s.ctx, db.CreateTeamParams{
-		ID:            int64(team.ID),
-		Nickname:      team.Name,
-		CompetitionID: int64(competitionId),
+	for _, venue := range venues {
+		if _, err := s.storeVenue(venue.Name, venue.City); err != nil {
+			return 0, err
+		}
+	}
+
+	unlinked, err := s.queries.ListUnlinkedFixtureVenues(s.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unlinked fixture venues: %w", err)
+	}
+
+	var linked int64
+	for _, fixtureVenue := range unlinked {
+		venueID, err := s.storeVenue(fixtureVenue.Venue, fixtureVenue.Venuecity)
+		if err != nil {
+			return linked, err
+		}
+
+		rows, err := s.queries.LinkFixturesToVenue(s.ctx, db.LinkFixturesToVenueParams{
+			VenueID:   &venueID,
+			Venue:     fixtureVenue.Venue,
+			VenueCity: fixtureVenue.Venuecity,
+		})
+		if err != nil {
+			return linked, fmt.Errorf("failed to link fixtures to venue: %w", err)
+		}
+		linked += rows
+	}
+
+	return linked, nil
+}
+
+// storeVenue stores the venue of a fixture with its location from config and
+// returns its ID. Venues are located by name if they have an override, then by
+// their city. A venue whose city is not known is logged and stored without a
+// location, so its kickoff times are given in UTC until it is added to config.
+func (s *NRLDataService) storeVenue(name, city string) (int32, error) {
+	location, ok := config.VenueLocationsByName[name]
+	if !ok {
+		location, ok = config.VenueLocations[city]
+	}
+	if !ok {
+		log.Printf("Venue %s is in %s, which is not in config.VenueLocations, so it has no timezone", name, city)
+	}
+
+	venue, err := s.queries.UpsertVenue(s.ctx, db.UpsertVenueParams{
+		Name:     name,
+		City:     city,
+		State:    optionalString(location.State),
+		Country:  optionalString(location.Country),
+		Timezone: optionalString(location.Timezone),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to store venue: %w", err)
+	}
+
+	return venue.ID, nil
+}
+
+// storeRound stores the round a fixture belongs to, along with its finals metadata,
+// and updates its kickoff window.
+func (s *NRLDataService) storeRound(compID, season, roundNumber int, roundTitle string) error {
+	roundType := utils.ParseRoundType(compID, roundTitle)
+
+	// Finals weeks are numbered in their title, except for the grand final
+	// which follows on from the last finals week stored.
+	var finalsWeek *int32
+	if roundType == config.RoundTypeFinals {
+		week, ok := utils.ParseFinalsWeek(roundTitle)
+		if !ok {
+			nextWeek, err := s.queries.GetNextFinalsWeek(s.ctx, db.GetNextFinalsWeekParams{
+				CompetitionID: int64(compID),
+				Season:        int32(season),
+				Number:        int32(roundNumber),
+			})
+			if err != nil {
+				return err
+			}
+			week = int(nextWeek)
+		}
+
+		week32 := int32(week)
+		finalsWeek = &week32
+	}
+
+	_, err := s.queries.UpsertRound(s.ctx, db.UpsertRoundParams{
+		CompetitionID: int64(compID),
+		Season:        int32(season),
+		Number:        int32(roundNumber),
+		Title:         roundTitle,
+		Slug:          utils.RoundSlug(roundTitle),
+		Type:          roundType,
+		FinalsWeek:    finalsWeek,
+		PointsWeight:  int32(utils.RoundPointsWeight(roundType, roundTitle)),
 	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.queries.RefreshRoundKickOffs(s.ctx, db.RefreshRoundKickOffsParams{
+		CompetitionID: int64(compID),
+		Season:        int32(season),
+		Number:        int32(roundNumber),
+	})
+	return err
+}
+
+// storeTeam stores a team in the database, creating it if it does not exist or
+// updating its details if it does, and records the competition season it is
+// playing in. Full details are only stored when the team list is given.
+func (s *NRLDataService) storeTeam(team models.NRLTeam, teamList *models.NRLTeamList, competitionId, season int) error {
+	arg := db.UpsertTeamParams{
+		ID:       int64(team.ID),
+		Nickname: team.Name,
+	}
+
+	if teamList != nil {
+		arg.Name = optionalString(teamList.Name)
+		arg.LogoUrl = optionalString(teamLogo(teamList.Theme.Logos))
+
+		if theme, ok := config.TeamThemes[teamList.Theme.Key]; ok {
+			arg.Code = &theme.Code
+			arg.PrimaryColour = &theme.PrimaryColour
+			arg.SecondaryColour = &theme.SecondaryColour
+		}
+	}
+
+	_, err := s.queries.UpsertTeam(s.ctx, arg)
 	if err != nil {
 		return fmt.Errorf("failed to store team: %w", err)
 	}
 
+	err = s.queries.AddTeamCompetition(s.ctx, db.AddTeamCompetitionParams{
+		TeamID:        int64(team.ID),
+		CompetitionID: int64(competitionId),
+		Season:        int32(season),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store team competition: %w", err)
+	}
+
+	return nil
+}
+
+// storeTeamList stores the players named by a team for a fixture. The first list
+// stored is the team announcement, after which every player added, removed or
+// moved to a different number or position is recorded as a late change. Once the
+// match has started the list is left as it was at kickoff.
+func (s *NRLDataService) storeTeamList(fixtureID, teamID int64, teamList models.NRLTeamList, started bool) error {
+	// Team lists are not published until the team announcement
+	if len(teamList.Players) == 0 {
+		return nil
+	}
+
+	lists, err := s.queries.ListTeamListsByFixtureID(s.ctx, fixtureID)
+	if err != nil {
+		return err
+	}
+
+	announced := make(map[int64]db.TeamList)
+	for _, l := range lists {
+		if l.TeamList.TeamID == teamID {
+			announced[l.TeamList.PlayerID] = l.TeamList
+		}
+	}
+	isAnnouncement := len(announced) == 0
+
+	if started && !isAnnouncement {
+		return nil
+	}
+
+	recordChange := func(playerID int64, change string, number int32, position string) error {
+		if isAnnouncement {
+			return nil
+		}
+		return s.queries.CreateTeamListChange(s.ctx, db.CreateTeamListChangeParams{
+			FixtureID: fixtureID,
+			TeamID:    teamID,
+			PlayerID:  playerID,
+			Change:    change,
+			Number:    number,
+			Position:  position,
+		})
+	}
+
+	for _, player := range teamList.Players {
+		playerID, number := int64(player.ID), int32(player.Number)
+
+		_, err := s.queries.UpsertPlayer(s.ctx, db.UpsertPlayerParams{
+			ID:        playerID,
+			FirstName: player.FirstName,
+			LastName:  player.LastName,
+		})
+		if err != nil {
+			return err
+		}
+
+		existing, ok := announced[playerID]
+		delete(announced, playerID)
+
+		if !ok {
+			err = s.queries.CreateTeamListPlayer(s.ctx, db.CreateTeamListPlayerParams{
+				FixtureID: fixtureID,
+				TeamID:    teamID,
+				PlayerID:  playerID,
+				Number:    number,
+				Position:  player.Position,
+			})
+			if err != nil {
+				return err
+			}
+			if err := recordChange(playerID, config.TeamListChangeIn, number, player.Position); err != nil {
+				return err
+			}
+		} else if existing.Number != number || existing.Position != player.Position {
+			err = s.queries.UpdateTeamListPlayer(s.ctx, db.UpdateTeamListPlayerParams{
+				FixtureID: fixtureID,
+				TeamID:    teamID,
+				PlayerID:  playerID,
+				Number:    number,
+				Position:  player.Position,
+			})
+			if err != nil {
+				return err
+			}
+			if err := recordChange(playerID, config.TeamListChangePosition, number, player.Position); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Any player left from the stored list is no longer named
+	for playerID, existing := range announced {
+		err := s.queries.DeleteTeamListPlayer(s.ctx, db.DeleteTeamListPlayerParams{
+			FixtureID: fixtureID,
+			TeamID:    teamID,
+			PlayerID:  playerID,
+		})
+		if err != nil {
+			return err
+		}
+		if err := recordChange(playerID, config.TeamListChangeOut, existing.Number, existing.Position); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// storeMatchStats replaces the timeline of a fixture and stores the statistics of
+// both teams from its match centre.
+func (s *NRLDataService) storeMatchStats(fixtureID int64, fixture models.NRLFixture) error {
+	if err := s.queries.DeleteMatchEvents(s.ctx, fixtureID); err != nil {
+		return err
+	}
+
+	for i, event := range fixture.MatchCentre.Timeline {
+		// Events such as half time do not belong to either team
+		var teamID *int64
+		if event.TeamID == fixture.HomeTeam.ID || event.TeamID == fixture.AwayTeam.ID {
+			teamID = optionalID(event.TeamID)
+		}
+
+		err := s.queries.CreateMatchEvent(s.ctx, db.CreateMatchEventParams{
+			FixtureID:     fixtureID,
+			Sequence:      int32(i + 1),
+			Type:          event.Type,
+			Title:         event.Title,
+			GameSeconds:   int32(event.GameSeconds),
+			TeamID:        teamID,
+			PlayerID:      optionalID(event.PlayerID),
+			HometeamScore: parseScore(event.HomeScore),
+			AwayteamScore: parseScore(event.AwayScore),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, group := range fixture.MatchCentre.Stats.Groups {
+		for _, stat := range group.Stats {
+			teams := []struct {
+				id    int
+				value *float64
+			}{
+				{fixture.HomeTeam.ID, stat.HomeValue.Value},
+				{fixture.AwayTeam.ID, stat.AwayValue.Value},
+			}
+
+			for _, team := range teams {
+				err := s.queries.UpsertMatchTeamStat(s.ctx, db.UpsertMatchTeamStatParams{
+					FixtureID: fixtureID,
+					TeamID:    int64(team.id),
+					Category:  group.Title,
+					Name:      stat.Title,
+					Value:     team.value,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
 // storeMatchDetails converts and stores match details in the database.
-func (s *NRLDataService) storeMatchDetails(fixtureID int64, fixture models.NRLFixture) error {
+func (s *NRLDataService) storeMatchDetails(fixtureID int64, fixture models.NRLFixture, stored *db.GetMatchDetailsByFixtureIDRow) error {
 	// Check if match details exist
-	checkFixture, _ := s.queries.GetMatchDetailsByFixtureID(s.ctx, fixtureID)
-	if checkFixture.MatchDetail.FixtureID == fixtureID {
+	if stored.MatchDetail.FixtureID == fixtureID {
+		if err := s.storeResultCorrection(fixtureID, fixture, stored); err != nil {
+			return err
+		}
+
 		// Match details already exist Update them
 		_, err := s.queries.UpdateMatchDetail(s.ctx, db.UpdateMatchDetailParams{
 			FixtureID:     fixtureID,
@@ -199,7 +749,7 @@ func (s *NRLDataService) storeMatchDetails(fixtureID int64, fixture models.NRLFi
 		if err != nil {
 			return fmt.Errorf("failed to update match details: %w", err)
 		}
-		return nil
+		return s.storeOdds(fixtureID, fixture)
 	}
 
 	_, err := s.queries.CreateMatchDetail(s.ctx, db.CreateMatchDetailParams{
@@ -218,6 +768,69 @@ func (s *NRLDataService) storeMatchDetails(fixtureID int64, fixture models.NRLFi
 		return fmt.Errorf("failed to store match details: %w", err)
 	}
 
+	return s.storeOdds(fixtureID, fixture)
+}
+
+// storeResultCorrection records a change to the score of a fixture that was
+// already complete when it was last stored, so amended results can be traced.
+func (s *NRLDataService) storeResultCorrection(fixtureID int64, fixture models.NRLFixture, stored *db.GetMatchDetailsByFixtureIDRow) error {
+	if stored.Fixture.Matchstate != config.MatchStateFullTime || fixture.MatchState != config.MatchStateFullTime {
+		return nil
+	}
+
+	previousHome, previousAway := stored.MatchDetail.HometeamScore, stored.MatchDetail.AwayteamScore
+	home, away := parseScore(fixture.HomeTeam.Score), parseScore(fixture.AwayTeam.Score)
+	if previousHome == nil || previousAway == nil || home == nil || away == nil {
+		return nil
+	}
+	if *previousHome == *home && *previousAway == *away {
+		return nil
+	}
+
+	_, err := s.queries.CreateResultCorrection(s.ctx, db.CreateResultCorrectionParams{
+		FixtureID:             fixtureID,
+		PreviousHometeamScore: *previousHome,
+		PreviousAwayteamScore: *previousAway,
+		HometeamScore:         *home,
+		AwayteamScore:         *away,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store result correction: %w", err)
+	}
+
+	winnerChanged := cmp.Compare(*previousHome, *previousAway) != cmp.Compare(*home, *away)
+	log.Printf("Result of fixture %d corrected from %d-%d to %d-%d (winner changed: %t)",
+		fixtureID, *previousHome, *previousAway, *home, *away, winnerChanged)
+
+	return nil
+}
+
+// storeOdds records the odds of a fixture if they have changed since they were
+// last recorded, so the market drift can be followed from when they were first
+// published.
+func (s *NRLDataService) storeOdds(fixtureID int64, fixture models.NRLFixture) error {
+	homeOdds, awayOdds := parseOdds(fixture.HomeTeam.Odds), parseOdds(fixture.AwayTeam.Odds)
+	if homeOdds == nil || awayOdds == nil || *homeOdds <= 0 || *awayOdds <= 0 {
+		return nil
+	}
+
+	latest, err := s.queries.GetLatestOddsByFixtureID(s.ctx, fixtureID)
+	if err == nil && latest.HometeamOdds == *homeOdds && latest.AwayteamOdds == *awayOdds {
+		return nil
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get latest odds: %w", err)
+	}
+
+	_, err = s.queries.CreateOddsHistory(s.ctx, db.CreateOddsHistoryParams{
+		FixtureID:    fixtureID,
+		HometeamOdds: *homeOdds,
+		AwayteamOdds: *awayOdds,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store odds history: %w", err)
+	}
+
 	return nil
 }
 
@@ -254,6 +867,51 @@ func parseForm(form []models.NRLForm) string {
 	return result
 }
 
+// addLadderResult adds the result of a match to a team's recalculated ladder entry.
+func addLadderResult(entry *db.LadderEntry, score, opponentScore int32) {
+	entry.Played++
+	entry.PointsFor += score
+	entry.PointsAgainst += opponentScore
+	entry.PointsDifference += score - opponentScore
+
+	switch {
+	case score > opponentScore:
+		entry.Wins++
+		entry.Points += config.LadderPointsWin
+	case score < opponentScore:
+		entry.Losses++
+	default:
+		entry.Draws++
+		entry.Points += config.LadderPointsDraw
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// teamLogo picks the team badge from the logos of a team theme, preferring
+// the SVG version. An empty string is returned if there is no badge.
+func teamLogo(logos map[string]string) string {
+	for _, name := range []string{"badge.svg", "badge.png"} {
+		if logo, ok := logos[name]; ok {
+			return logo
+		}
+	}
+	return ""
+}
+
+func optionalID(id int) *int64 {
+	if id == 0 {
+		return nil
+	}
+	idValue := int64(id)
+	return &idValue
+}
+
 func parseWinnerTeamID(fixture models.NRLFixture) *int64 {
 
 	if fixture.MatchState != config.MatchStateFullTime {
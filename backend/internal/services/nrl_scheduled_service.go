@@ -8,12 +8,14 @@ import (
 
 	"github.com/aussiebroadwan/tipping/backend/config"
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
+	"github.com/aussiebroadwan/tipping/backend/internal/utils"
 )
 
 // NRLScheduledService handles the scheduled fetching of data from the NRL API.
 type NRLScheduledService struct {
 	nrlService       *NRLService
 	dataService      *NRLDataService
+	ratingService    *RatingService
 	competitionIDs   []int64
 	scheduleChan     chan models.NRLFixture
 	scheduledMatches map[string]struct{}
@@ -21,10 +23,11 @@ type NRLScheduledService struct {
 }
 
 // NewNRLScheduledService creates a new instance of NRLScheduledService.
-func NewNRLScheduledService(nrlService *NRLService, dataService *NRLDataService, competitionIDs []int64) *NRLScheduledService {
+func NewNRLScheduledService(nrlService *NRLService, dataService *NRLDataService, ratingService *RatingService, competitionIDs []int64) *NRLScheduledService {
 	return &NRLScheduledService{
 		nrlService:       nrlService,
 		dataService:      dataService,
+		ratingService:    ratingService,
 		competitionIDs:   competitionIDs,
 		scheduleChan:     make(chan models.NRLFixture, 100),
 		scheduledMatches: make(map[string]struct{}),
@@ -59,20 +62,29 @@ func (s *NRLScheduledService) FetchAndStoreData(ctx context.Context) {
 	log.Println("Starting scheduled fetch of NRL data")
 
 	for _, competitionID := range s.competitionIDs {
-		// Fetch fixtures for the current season.
-		fixtures, err := s.nrlService.FetchFixtures(competitionID, 0, time.Now().Year())
+		// Fetch the draw for the current season.
+		season := time.Now().Year()
+		draw, err := s.nrlService.FetchDraw(competitionID, 0, season)
 		if err != nil {
 			log.Printf("Error fetching fixtures for competition %d: %v", competitionID, err)
 			continue
 		}
+		fixtures := draw.Fixtures
 		time.Sleep(1 * time.Second)
 
-		// Store each fetched fixture and its details.
+		// Store each fetched fixture and its details, skipping fixtures that
+		// have not changed since the last fetch.
+		unchanged := 0
 		for _, fixture := range fixtures {
-			err := s.dataService.StoreFixtureAndDetails(fixture)
-			if err != nil {
-				log.Printf("Error storing fixture ID %s: %v", fixture.ID, err)
-				continue
+			if fixture.NotModified {
+				unchanged++
+			} else {
+				if err := s.dataService.StoreFixtureAndDetails(fixture); err != nil {
+					log.Printf("Error storing fixture ID %s: %v", fixture.ID, err)
+					s.nrlService.InvalidateMatchDetail(fixture.MatchCentreURL)
+					continue
+				}
+				s.nrlService.CommitMatchDetail(fixture.MatchCentreURL)
 			}
 
 			// Schedule match monitoring for "Upcoming" fixtures
@@ -81,13 +93,108 @@ func (s *NRLScheduledService) FetchAndStoreData(ctx context.Context) {
 			}
 		}
 
-		log.Printf("Fetched and stored %d fixtures for competition %d", len(fixtures), competitionID)
+		// Store the teams on the bye once the round has been stored with its fixtures.
+		// If they cannot be stored, the draw is not cached so they are stored again
+		// on the next fetch.
+		byesStored := true
+		if !draw.NotModified && draw.SelectedRoundID > 0 {
+			if err := s.dataService.StoreRoundByes(competitionID, *draw); err != nil {
+				log.Printf("Error storing byes for competition %d: %v", competitionID, err)
+				byesStored = false
+			}
+		}
+		if byesStored {
+			s.nrlService.CommitDraw(competitionID, 0, season)
+		} else {
+			s.nrlService.InvalidateDraw(competitionID, 0, season)
+		}
+
+		log.Printf("Fetched %d fixtures for competition %d, %d unchanged", len(fixtures), competitionID, unchanged)
+		time.Sleep(1 * time.Second)
+
+		// Refetch the rounds before the current one, so corrections made to
+		// their results after full time are stored before the ratings are updated
+		s.reconcileResults(competitionID, *draw)
+
+		if utils.HasLadder(int(competitionID)) {
+			s.fetchAndStoreLadder(competitionID)
+		}
+
+		// Predict the new fixtures from the latest results
+		if err := s.ratingService.UpdateRatings(competitionID); err != nil {
+			log.Printf("Error updating ratings for competition %d: %v", competitionID, err)
+		}
 		time.Sleep(5 * time.Second)
 	}
 
+	stats := s.nrlService.CacheStats()
+	log.Printf("NRL response cache: %d hits, %d misses (%.1f%% hit rate)", stats.Hits, stats.Misses, stats.HitRate*100)
+
 	log.Println("Completed scheduled fetch of NRL data")
 }
 
+// reconcileResults refetches the recently completed rounds before the current
+// round of a draw and stores their changed fixtures, recording any corrections
+// to their results.
+func (s *NRLScheduledService) reconcileResults(competitionID int64, draw models.NRLDraw) {
+	for round := max(draw.SelectedRoundID-config.CorrectionRounds, 1); round < draw.SelectedRoundID; round++ {
+		roundDraw, err := s.nrlService.FetchDraw(competitionID, round, draw.SelectedSeasonID)
+		if err != nil {
+			log.Printf("Error fetching round %d for competition %d: %v", round, competitionID, err)
+			continue
+		}
+
+		for _, fixture := range roundDraw.Fixtures {
+			if fixture.NotModified || fixture.MatchState != config.MatchStateFullTime {
+				continue
+			}
+			if err := s.dataService.StoreFixtureAndDetails(fixture); err != nil {
+				log.Printf("Error storing fixture ID %s: %v", fixture.ID, err)
+				s.nrlService.InvalidateMatchDetail(fixture.MatchCentreURL)
+				continue
+			}
+			s.nrlService.CommitMatchDetail(fixture.MatchCentreURL)
+		}
+		s.nrlService.CommitDraw(competitionID, round, draw.SelectedSeasonID)
+		time.Sleep(1 * time.Second)
+	}
+}
+
+// fetchAndStoreLadder fetches the latest ladder of a competition, stores it if it
+// has changed, and logs any differences from the ladder recalculated from results.
+func (s *NRLScheduledService) fetchAndStoreLadder(competitionID int64) {
+	season := time.Now().Year()
+	ladder, err := s.nrlService.FetchLadder(competitionID, 0, season)
+	if err != nil {
+		log.Printf("Error fetching ladder for competition %d: %v", competitionID, err)
+		return
+	}
+
+	if ladder.NotModified || ladder.SelectedRoundID == 0 {
+		return
+	}
+
+	// If the ladder cannot be stored or checked, it is not cached so it is
+	// processed again on the next fetch
+	if err := s.dataService.StoreLadder(competitionID, *ladder); err != nil {
+		log.Printf("Error storing ladder for competition %d: %v", competitionID, err)
+		s.nrlService.InvalidateLadder(competitionID, 0, season)
+		return
+	}
+
+	discrepancies, err := s.dataService.CheckLadder(competitionID, int32(ladder.SelectedSeasonID), int32(ladder.SelectedRoundID))
+	if err != nil {
+		log.Printf("Error checking ladder for competition %d: %v", competitionID, err)
+		s.nrlService.InvalidateLadder(competitionID, 0, season)
+		return
+	}
+	s.nrlService.CommitLadder(competitionID, 0, season)
+
+	for _, discrepancy := range discrepancies {
+		log.Printf("Ladder discrepancy for competition %d round %d: %s", competitionID, ladder.SelectedRoundID, discrepancy)
+	}
+}
+
 // scheduleMatchMonitoring schedules a match to be checked 80 minutes after its kickoff time.
 func (s *NRLScheduledService) scheduleMatchMonitoring(fixture models.NRLFixture) {
 	s.mu.Lock()
@@ -150,6 +257,7 @@ func (s *NRLScheduledService) checkMatchStatus(ctx context.Context, fixture mode
 		log.Printf("Error updating match details for fixture %s: %v", fixture.ID, err)
 		return
 	}
+	s.nrlService.CommitMatchDetail(fixture.MatchCentreURL)
 
 	// If the match is still not "FullTime", reschedule another check in 5 minutes
 	if updatedFixture.MatchState != config.MatchStateFullTime {
@@ -177,10 +285,17 @@ func (s *NRLScheduledService) checkMatchStatus(ctx context.Context, fixture mode
 			return
 		}
 
-		// Update the Score and Winner
-		if err = s.dataService.UpdateMatchScores(fixture.ID, fixture.HomeTeam.ID, fixture.HomeTeam.Score, fixture.AwayTeam.ID, fixture.AwayTeam.Score); err != nil {
+		// Update the Score and Winner from the final match details
+		home, away := updatedFixture.HomeTeam, updatedFixture.AwayTeam
+		if err = s.dataService.UpdateMatchScores(fixture.ID, home.ID, home.Score, away.ID, away.Score); err != nil {
 			log.Printf("Error updating match scores for fixture %s: %v", fixture.ID, err)
 			return
 		}
+
+		// Update the ratings with the result
+		_, competitionID, _, _ := utils.ParseMatchID(fixture.ID)
+		if err = s.ratingService.UpdateRatings(int64(competitionID)); err != nil {
+			log.Printf("Error updating ratings for competition %d: %v", competitionID, err)
+		}
 	}
 }
@@ -3,9 +3,14 @@ package services
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/aussiebroadwan/tipping/backend/config"
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
 )
 
@@ -13,6 +18,7 @@ import (
 type NRLService struct {
 	baseURL string
 	client  *http.Client
+	cache   *NRLCacheService
 }
 
 // NewNRLService creates a new instance of NRLService with default settings.
@@ -25,47 +31,73 @@ func NewNRLService(baseURL string) *NRLService {
 	}
 }
 
+// NewCachedNRLService creates a new instance of NRLService which uses the given
+// cache to detect documents that have not changed since they were last fetched.
+func NewCachedNRLService(baseURL string, cache *NRLCacheService) *NRLService {
+	s := NewNRLService(baseURL)
+	s.cache = cache
+	return s
+}
+
 // FetchFixtures fetches all fixtures for a given competition ID and enriches each fixture
 // with additional details such as odds and recent form from their respective matchCentreURLs.
 func (s *NRLService) FetchFixtures(competitionID int64, roundNum, season int) ([]models.NRLFixture, error) {
-	url := fmt.Sprintf("%s/draw/data?competition=%d", s.baseURL, competitionID)
-
-	if competitionID == 0 {
-		return nil, fmt.Errorf("competition ID is required")
+	draw, err := s.FetchDraw(competitionID, roundNum, season)
+	if err != nil {
+		return nil, err
 	}
 
-	if roundNum > 0 {
-		url = fmt.Sprintf("%s&round=%d", url, roundNum)
-	}
+	return draw.Fixtures, nil
+}
 
-	if season > 0 {
-		url = fmt.Sprintf("%s&season=%d", url, season)
+// FetchDraw fetches the draw for a given competition ID, including the fixtures and the
+// teams on the bye. Each fixture is enriched with additional details such as odds and
+// recent form from their respective matchCentreURLs.
+//
+// When a cache is configured, fixtures whose draw and match centre documents are
+// both unchanged are marked as NotModified. Their match centre documents are only
+// decoded if the match is still upcoming, so it can continue to be monitored. If
+// any match centre document cannot be fetched, the cached draw is invalidated so
+// the changes in the draw are not lost on the next fetch. Changed documents are
+// only cached once committed with CommitDraw and CommitMatchDetail.
+func (s *NRLService) FetchDraw(competitionID int64, roundNum, season int) (*models.NRLDraw, error) {
+	if competitionID == 0 {
+		return nil, fmt.Errorf("competition ID is required")
 	}
+	url := s.documentURL("draw", competitionID, roundNum, season)
 
 	// Step 1: Fetch basic fixtures data from the main draw endpoint.
-	resp, err := s.client.Get(url)
+	body, drawModified, err := s.fetchDocument(url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch fixtures: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code from fixtures endpoint: %d", resp.StatusCode)
-	}
-
-	var response struct {
-		Fixtures []models.NRLFixture `json:"fixtures"`
-	}
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var response models.NRLDraw
+	err = json.Unmarshal(body, &response)
 	if err != nil {
+		s.invalidateDocument(url)
 		return nil, fmt.Errorf("failed to decode fixtures response: %w", err)
 	}
+	response.NotModified = !drawModified
 
 	// Step 2: Iterate over each fixture to fetch additional details.
 	for i, fixture := range response.Fixtures {
-		matchDetail, err := s.fetchMatchDetail(fixture.MatchCentreURL)
+		body, modified, err := s.fetchDocument(s.matchDetailURL(fixture.MatchCentreURL))
+		if err != nil {
+			s.invalidateDocument(url)
+			return nil, fmt.Errorf("failed to fetch match details for fixture %s: %w", fixture.ID, err)
+		}
+
+		if !drawModified && !modified {
+			response.Fixtures[i].NotModified = true
+			if fixture.MatchState != config.MatchStateUpcoming {
+				continue
+			}
+		}
+
+		matchDetail, err := s.decodeMatchDetail(body)
 		if err != nil {
+			s.invalidateDocument(url)
 			return nil, fmt.Errorf("failed to fetch match details for fixture %s: %w", fixture.ID, err)
 		}
 
@@ -78,30 +110,211 @@ func (s *NRLService) FetchFixtures(competitionID int64, roundNum, season int) ([
 		response.Fixtures[i].AwayTeam.Score = matchDetail.AwayTeam.Score
 		response.Fixtures[i].HomeTeam.Form = matchDetail.HomeTeam.Form
 		response.Fixtures[i].AwayTeam.Form = matchDetail.AwayTeam.Form
+		response.Fixtures[i].MatchCentre = matchDetail.MatchCentre
+	}
+
+	return &response, nil
+}
+
+// FetchLadder fetches the ladder of a given competition ID as at a round. If no
+// round is given, the ladder after the latest completed round is fetched. A
+// changed ladder is only cached once committed with CommitLadder.
+func (s *NRLService) FetchLadder(competitionID int64, roundNum, season int) (*models.NRLLadder, error) {
+	if competitionID == 0 {
+		return nil, fmt.Errorf("competition ID is required")
+	}
+	url := s.documentURL("ladder", competitionID, roundNum, season)
+
+	body, modified, err := s.fetchDocument(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ladder: %w", err)
+	}
+
+	var response models.NRLLadder
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		s.invalidateDocument(url)
+		return nil, fmt.Errorf("failed to decode ladder response: %w", err)
+	}
+	response.NotModified = !modified
+
+	return &response, nil
+}
+
+// CacheStats returns the response cache metrics, or empty metrics if no cache
+// is configured.
+func (s *NRLService) CacheStats() models.APICacheStats {
+	if s.cache == nil {
+		return models.APICacheStats{}
+	}
+	return s.cache.Stats()
+}
+
+// CommitDraw caches the draw fetched with the same arguments once its fixtures
+// and byes have been stored, so it is treated as unchanged on the next fetch.
+func (s *NRLService) CommitDraw(competitionID int64, roundNum, season int) {
+	s.commitDocument(s.documentURL("draw", competitionID, roundNum, season))
+}
+
+// CommitLadder caches the ladder fetched with the same arguments once it has
+// been stored, so it is treated as unchanged on the next fetch.
+func (s *NRLService) CommitLadder(competitionID int64, roundNum, season int) {
+	s.commitDocument(s.documentURL("ladder", competitionID, roundNum, season))
+}
+
+// CommitMatchDetail caches the match details fetched for a matchCentreURL once
+// the fixture has been stored, so it is treated as unchanged on the next fetch.
+func (s *NRLService) CommitMatchDetail(matchCentreURL string) {
+	s.commitDocument(s.matchDetailURL(matchCentreURL))
+}
+
+// InvalidateDraw clears any cached draw fetched with the same arguments, so the
+// draw is processed again on the next fetch even if unchanged.
+func (s *NRLService) InvalidateDraw(competitionID int64, roundNum, season int) {
+	s.invalidateDocument(s.documentURL("draw", competitionID, roundNum, season))
+}
+
+// InvalidateLadder clears any cached ladder fetched with the same arguments, so
+// the ladder is processed again on the next fetch even if unchanged.
+func (s *NRLService) InvalidateLadder(competitionID int64, roundNum, season int) {
+	s.invalidateDocument(s.documentURL("ladder", competitionID, roundNum, season))
+}
+
+// InvalidateMatchDetail clears any cached match details for a matchCentreURL,
+// so the fixture is processed again on the next fetch even if unchanged.
+func (s *NRLService) InvalidateMatchDetail(matchCentreURL string) {
+	s.invalidateDocument(s.matchDetailURL(matchCentreURL))
+}
+
+// commitDocument caches the response fetched for a URL if a cache is configured.
+func (s *NRLService) commitDocument(url string) {
+	if s.cache != nil {
+		s.cache.Commit(url)
+	}
+}
+
+// invalidateDocument clears the cached response for a URL if a cache is configured.
+func (s *NRLService) invalidateDocument(url string) {
+	if s.cache != nil {
+		s.cache.Invalidate(url)
+	}
+}
+
+// documentURL returns the full URL for the draw or ladder data of a competition,
+// optionally for a round and season.
+func (s *NRLService) documentURL(document string, competitionID int64, roundNum, season int) string {
+	url := fmt.Sprintf("%s/%s/data?competition=%d", s.baseURL, document, competitionID)
+
+	if roundNum > 0 {
+		url = fmt.Sprintf("%s&round=%d", url, roundNum)
 	}
 
-	return response.Fixtures, nil
+	if season > 0 {
+		url = fmt.Sprintf("%s&season=%d", url, season)
+	}
+
+	return url
 }
 
 // fetchMatchDetail fetches additional match details for a specific fixture using its matchCentreURL.
 func (s *NRLService) fetchMatchDetail(matchCentreURL string) (*models.NRLFixture, error) {
-	// Full URL for the match details data
-	url := fmt.Sprintf("%s%sdata", s.baseURL, matchCentreURL)
-	resp, err := s.client.Get(url)
+	body, _, err := s.fetchDocument(s.matchDetailURL(matchCentreURL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch match details from %s: %w", matchCentreURL, err)
 	}
+
+	return s.decodeMatchDetail(body)
+}
+
+// matchDetailURL returns the full URL for the match details data of a matchCentreURL.
+func (s *NRLService) matchDetailURL(matchCentreURL string) string {
+	return fmt.Sprintf("%s%sdata", s.baseURL, matchCentreURL)
+}
+
+// fetchDocument fetches a document from the NRL API and reports whether it has
+// changed since it was last fetched. If a cache is configured, conditional
+// request headers are sent and the cached body is returned when the server
+// responds with 304 Not Modified. A changed document is only cached once it is
+// committed by the caller. Without a cache every document is modified.
+func (s *NRLService) fetchDocument(url string) ([]byte, bool, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cached := s.cachedResponse(url)
+	if cached != nil {
+		if cached.Etag != nil {
+			req.Header.Set("If-None-Match", *cached.Etag)
+		}
+		if cached.LastModified != nil {
+			req.Header.Set("If-Modified-Since", *cached.LastModified)
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		s.cache.NotModified(url)
+		return cached.Body, false, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code from match details endpoint: %d", resp.StatusCode)
+		return nil, false, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	if s.cache == nil {
+		return body, true, nil
+	}
+
+	modified := s.cache.Store(url, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), body, cached)
+	return body, modified, nil
+}
+
+// cachedResponse returns the cached response for a URL if a cache is configured.
+func (s *NRLService) cachedResponse(url string) *db.NrlResponseCache {
+	if s.cache == nil {
+		return nil
+	}
+	return s.cache.Get(url)
+}
+
+// decodeMatchDetail decodes a match details document into an NRLFixture, along
+// with the match centre data such as the team lists.
+func (s *NRLService) decodeMatchDetail(body []byte) (*models.NRLFixture, error) {
 	var matchDetail models.NRLFixture
-	err = json.NewDecoder(resp.Body).Decode(&matchDetail)
+	err := json.Unmarshal(body, &matchDetail)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode match details response: %w", err)
 	}
 
+	// The match centre data is not required to store the fixture, so a document
+	// which cannot be decoded is logged rather than failing the fixture.
+	var matchCentre models.NRLMatchCentre
+	err = json.Unmarshal(body, &matchCentre)
+	if err != nil {
+		log.Printf("Error decoding match centre for fixture %s: %v", matchDetail.ID, err)
+		return &matchDetail, nil
+	}
+
+	// Team logos are given relative to the NRL website.
+	for _, team := range []*models.NRLTeamList{&matchCentre.HomeTeam, &matchCentre.AwayTeam} {
+		for name, logo := range team.Theme.Logos {
+			if strings.HasPrefix(logo, "/") {
+				team.Theme.Logos[name] = s.baseURL + logo
+			}
+		}
+	}
+	matchDetail.MatchCentre = &matchCentre
+
 	return &matchDetail, nil
 }
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aussiebroadwan/tipping/backend/config"
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+	"github.com/aussiebroadwan/tipping/backend/internal/utils"
+)
+
+// RatingService rates teams from their stored results and predicts their fixtures.
+type RatingService struct {
+	queries *db.Queries
+	ctx     context.Context
+}
+
+// NewRatingService creates a new instance of RatingService.
+func NewRatingService(queries *db.Queries, ctx context.Context) *RatingService {
+	return &RatingService{
+		queries: queries,
+		ctx:     ctx,
+	}
+}
+
+// UpdateRatings replays every stored result of a competition in the order they
+// kicked off, rating each team with Elo. Every fixture is predicted from the
+// ratings before it, so completed matches keep the prediction made beforehand,
+// and the ratings and predictions are stored together. The home advantage is
+// only given at the grounds of the home team, found from the fixtures before,
+// not at neutral venues.
+func (s *RatingService) UpdateRatings(competitionID int64) error {
+	fixtures, err := s.queries.ListRatingFixturesByCompetitionID(s.ctx, competitionID)
+	if err != nil {
+		return fmt.Errorf("failed to list rating fixtures: %w", err)
+	}
+
+	grounds := newHomeGrounds()
+	predictions := db.UpsertFixturePredictionsParams{}
+	ratings := make(map[int64]*db.TeamRating)
+	season := int32(0)
+	for _, f := range fixtures {
+		// Ratings are carried into a new season partly regressed to the initial rating
+		if f.Season != season {
+			if season != 0 {
+				regressRatings(ratings, f.Season)
+			}
+			season = f.Season
+		}
+
+		home := teamRating(ratings, competitionID, f.HometeamID, season)
+		away := teamRating(ratings, competitionID, f.AwayteamID, season)
+
+		ratingDiff := home.Rating - away.Rating
+		if grounds.isHomeGround(f.HometeamID, f.VenueID) {
+			ratingDiff += config.RatingHomeAdvantage
+		}
+		grounds.add(f.HometeamID, f.VenueID)
+		probability := utils.EloWinProbability(ratingDiff)
+
+		predictions.FixtureIds = append(predictions.FixtureIds, f.ID)
+		predictions.HomeRatings = append(predictions.HomeRatings, home.Rating)
+		predictions.AwayRatings = append(predictions.AwayRatings, away.Rating)
+		predictions.HomeWinProbabilities = append(predictions.HomeWinProbabilities, probability)
+		predictions.PredictedMargins = append(predictions.PredictedMargins, ratingDiff/config.RatingPointsPerMargin)
+
+		if f.Matchstate != config.MatchStateFullTime || f.HometeamScore == nil || f.AwayteamScore == nil {
+			continue
+		}
+
+		margin := *f.HometeamScore - *f.AwayteamScore
+		result, winnerRatingDiff := 0.5, ratingDiff
+		if margin > 0 {
+			result = 1
+		} else if margin < 0 {
+			result, winnerRatingDiff = 0, -ratingDiff
+		}
+
+		change := config.RatingK * utils.EloMarginMultiplier(margin, winnerRatingDiff) * (result - probability)
+		home.Rating += change
+		away.Rating -= change
+		home.Matches++
+		away.Matches++
+	}
+
+	if err := s.queries.UpsertFixturePredictions(s.ctx, predictions); err != nil {
+		return fmt.Errorf("failed to store fixture predictions: %w", err)
+	}
+
+	teamRatings := db.UpsertTeamRatingsParams{CompetitionID: competitionID}
+	for _, r := range ratings {
+		teamRatings.TeamIds = append(teamRatings.TeamIds, r.TeamID)
+		teamRatings.Ratings = append(teamRatings.Ratings, r.Rating)
+		teamRatings.Matches = append(teamRatings.Matches, r.Matches)
+		teamRatings.Seasons = append(teamRatings.Seasons, r.Season)
+	}
+	if err := s.queries.UpsertTeamRatings(s.ctx, teamRatings); err != nil {
+		return fmt.Errorf("failed to store team ratings: %w", err)
+	}
+
+	return nil
+}
+
+// homeGrounds counts the home fixtures of each team at each venue as fixtures are
+// replayed, so the grounds of a team are found only from the fixtures before the
+// one being rated.
+type homeGrounds struct {
+	fixtures map[int64]int
+	venues   map[int64]map[int32]int
+}
+
+func newHomeGrounds() *homeGrounds {
+	return &homeGrounds{
+		fixtures: make(map[int64]int),
+		venues:   make(map[int64]map[int32]int),
+	}
+}
+
+// isHomeGround reports whether a team has played enough of its home fixtures at
+// a venue for it to be counted as its ground, so neutral venues such as Magic
+// Round are excluded. Without a venue or earlier home fixtures to compare, the
+// venue is assumed to be the team's ground.
+func (g *homeGrounds) isHomeGround(teamID int64, venueID *int32) bool {
+	if venueID == nil || g.fixtures[teamID] == 0 {
+		return true
+	}
+	return float64(g.venues[teamID][*venueID])/float64(g.fixtures[teamID]) >= config.RatingHomeGroundShare
+}
+
+// add counts a home fixture of a team at a venue.
+func (g *homeGrounds) add(teamID int64, venueID *int32) {
+	if venueID == nil {
+		return
+	}
+	if g.venues[teamID] == nil {
+		g.venues[teamID] = make(map[int32]int)
+	}
+	g.venues[teamID][*venueID]++
+	g.fixtures[teamID]++
+}
+
+// teamRating returns the rating of a team being replayed, starting new teams on
+// the initial rating.
+func teamRating(ratings map[int64]*db.TeamRating, competitionID, teamID int64, season int32) *db.TeamRating {
+	rating, ok := ratings[teamID]
+	if !ok {
+		rating = &db.TeamRating{
+			CompetitionID: competitionID,
+			TeamID:        teamID,
+			Rating:        config.RatingInitial,
+			Season:        season,
+		}
+		ratings[teamID] = rating
+	}
+
+	return rating
+}
+
+// regressRatings carries every rating into a new season, keeping only part of
+// the difference from the initial rating.
+func regressRatings(ratings map[int64]*db.TeamRating, season int32) {
+	for _, r := range ratings {
+		r.Rating = config.RatingInitial + (r.Rating-config.RatingInitial)*config.RatingSeasonCarryOver
+		r.Season = season
+	}
+}
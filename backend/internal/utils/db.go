@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// dbEnvVars are the environment variables required to connect to the database.
+var dbEnvVars = []string{
+	"DB_HOST",
+	"DB_PORT",
+	"DB_USER",
+	"DB_PASSWORD",
+	"DB_NAME",
+}
+
+// MissingDBEnvVars returns the database environment variables which are not set.
+func MissingDBEnvVars() []string {
+	var missing []string
+	for _, envVar := range dbEnvVars {
+		if os.Getenv(envVar) == "" {
+			missing = append(missing, envVar)
+		}
+	}
+	return missing
+}
+
+// ConnectDB connects to the database given by the DB_* environment variables.
+func ConnectDB(ctx context.Context) (*pgx.Conn, error) {
+	psqlInfo := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_NAME"),
+	)
+
+	return pgx.Connect(ctx, psqlInfo)
+}
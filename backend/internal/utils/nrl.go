@@ -1,6 +1,12 @@
 package utils
 
-import "strconv"
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aussiebroadwan/tipping/backend/config"
+)
 
 func ParseMatchID(id string) (season, competition, round, game int) {
 	season, _ = strconv.Atoi(id[0:4])
@@ -10,3 +16,87 @@ func ParseMatchID(id string) (season, competition, round, game int) {
 
 	return
 }
+
+// ParseRoundType determines the type of a round from its competition and title.
+// State of Origin games are always Origin rounds, otherwise any round that is
+// not titled "Round N" is part of the finals series.
+func ParseRoundType(competition int, roundTitle string) string {
+	if competition == config.CompetitionStateOfOrigin || competition == config.CompetitionStateOfOriginWomens {
+		return config.RoundTypeOrigin
+	}
+
+	if strings.HasPrefix(roundTitle, "Round ") {
+		return config.RoundTypeRegular
+	}
+
+	return config.RoundTypeFinals
+}
+
+// IsSeries reports whether a competition is played as a series of games, such
+// as State of Origin, rather than rounds.
+func IsSeries(competition int) bool {
+	return competition == config.CompetitionStateOfOrigin || competition == config.CompetitionStateOfOriginWomens
+}
+
+// HasLadder reports whether a competition has a ladder. State of Origin is
+// played as a series, so it does not.
+func HasLadder(competition int) bool {
+	return competition != config.CompetitionStateOfOrigin && competition != config.CompetitionStateOfOriginWomens
+}
+
+var roundNumberPattern = regexp.MustCompile(`^(?:Round|Game) (\d+)$`)
+
+// ParseRoundNumber extracts the number from a round title such as "Round 26"
+// or "Game 2". Finals titles are not numbered, so they return false.
+func ParseRoundNumber(roundTitle string) (int, bool) {
+	match := roundNumberPattern.FindStringSubmatch(roundTitle)
+	if match == nil {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
+
+var finalsWeekPattern = regexp.MustCompile(`^Finals Week (\d+)$`)
+
+// ParseFinalsWeek extracts the week from a finals round title such as
+// "Finals Week 2". The grand final and any other title return false, as their
+// week depends on how many finals weeks were played before them.
+func ParseFinalsWeek(roundTitle string) (int, bool) {
+	match := finalsWeekPattern.FindStringSubmatch(roundTitle)
+	if match == nil {
+		return 0, false
+	}
+
+	week, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return week, true
+}
+
+// RoundPointsWeight returns the weight applied to tips on fixtures of a round,
+// so that finals matches can be worth more than regular season matches.
+func RoundPointsWeight(roundType, roundTitle string) int {
+	if roundType != config.RoundTypeFinals {
+		return config.PointsWeightRegular
+	}
+
+	if roundTitle == config.RoundTitleGrandFinal {
+		return config.PointsWeightGrandFinal
+	}
+
+	return config.PointsWeightFinals
+}
+
+// RoundSlug converts a round title into the slug used to address the round
+// (e.g., "Finals Week 1" becomes "finals-week-1").
+func RoundSlug(roundTitle string) string {
+	return strings.ToLower(strings.Join(strings.Fields(roundTitle), "-"))
+}
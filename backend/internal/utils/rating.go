@@ -0,0 +1,27 @@
+package utils
+
+import "math"
+
+// EloWinProbability returns the probability of a team winning from the difference
+// between its rating and its opponent's, including any home advantage.
+func EloWinProbability(ratingDiff float64) float64 {
+	return 1 / (1 + math.Pow(10, -ratingDiff/400))
+}
+
+// EloMarginMultiplier scales the rating change of a match by its winning margin.
+// The multiplier is damped when the higher rated team wins, so ratings do not
+// keep inflating from favourites winning big. A draw has no margin to scale by.
+func EloMarginMultiplier(margin int32, winnerRatingDiff float64) float64 {
+	if margin == 0 {
+		return 1
+	}
+
+	return math.Log(math.Abs(float64(margin))+1) * 2.2 / (winnerRatingDiff*0.001 + 2.2)
+}
+
+// ImpliedProbability returns the probability of the home team winning implied by
+// the decimal odds of both teams, normalised to remove the bookmaker's margin.
+func ImpliedProbability(homeOdds, awayOdds float64) float64 {
+	home, away := 1/homeOdds, 1/awayOdds
+	return home / (home + away)
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"sync"
+	"time"
+
+	// The release image has no timezone database, so embed one in the binary.
+	_ "time/tzdata"
+)
+
+var locations sync.Map
+
+// LoadLocation returns the location for an IANA timezone name (e.g.,
+// Australia/Brisbane). Locations are cached as they are loaded for every
+// fixture returned by the API.
+func LoadLocation(name string) (*time.Location, error) {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, location)
+	return location, nil
+}
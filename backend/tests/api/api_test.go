@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
 	"github.com/stretchr/testify/assert"
@@ -85,6 +86,288 @@ func TestGetCompetitionRoundFixturesAPI(t *testing.T) {
 	assert.Equal(t, 1, len(fixtures))
 }
 
+func TestGetCompetitionSeasonFixturesAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/111?round=all&season=2023", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var fixtures []models.APIFixture
+	err = json.Unmarshal(rr.Body.Bytes(), &fixtures)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(fixtures))
+}
+
+func TestGetCompetitionRoundFixturesBySlugAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/111?round=round-27", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var fixtures []models.APIFixture
+	err = json.Unmarshal(rr.Body.Bytes(), &fixtures)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(fixtures))
+	assert.Equal(t, int32(27), fixtures[0].RoundNumber)
+	assert.Equal(t, int32(1), fixtures[0].PointsWeight)
+
+	// A round that has not been played yet has no fixtures
+	req, err = http.NewRequest("GET", "/api/v1/fixtures/111?round=grand-final", nil)
+	assert.NoError(t, err)
+
+	rr = httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "[]\n", rr.Body.String())
+
+	req, err = http.NewRequest("GET", "/api/v1/fixtures/111?round=Grand%20Final", nil)
+	assert.NoError(t, err)
+
+	rr = httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetCompetitionRoundsAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/competitions/111/rounds", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var rounds []models.APIRound
+	err = json.Unmarshal(rr.Body.Bytes(), &rounds)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rounds))
+
+	assert.Equal(t, int32(26), rounds[0].Number)
+	assert.Equal(t, "Round 26", rounds[0].Title)
+	assert.Equal(t, "round-26", rounds[0].Slug)
+	assert.Equal(t, "Regular", rounds[0].Type)
+	assert.Nil(t, rounds[0].FinalsWeek)
+	assert.Equal(t, int32(1), rounds[0].PointsWeight)
+	assert.NotNil(t, rounds[0].FirstKickOff)
+	assert.Equal(t, 0, len(rounds[0].Byes))
+
+	// The kickoff window of a round spans its first and last fixtures
+	req, err = http.NewRequest("GET", "/api/v1/competitions/111/rounds?season=2022", nil)
+	assert.NoError(t, err)
+
+	rr = httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	err = json.Unmarshal(rr.Body.Bytes(), &rounds)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(rounds)) && assert.NotNil(t, rounds[0].FirstKickOff) && assert.NotNil(t, rounds[0].LastKickOff) {
+		assert.Equal(t, time.Date(2022, 3, 10, 9, 0, 0, 0, time.UTC), *rounds[0].FirstKickOff)
+		assert.Equal(t, time.Date(2022, 3, 13, 6, 5, 0, 0, time.UTC), *rounds[0].LastKickOff)
+	}
+}
+
+func TestGetCompetitionRoundsInvalidID(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/competitions/999/rounds", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetCompetitionLadderAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/competitions/111/ladder", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var ladder []models.APILadderEntry
+	err = json.Unmarshal(rr.Body.Bytes(), &ladder)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(ladder))
+
+	assert.Equal(t, int32(1), ladder[0].Position)
+	assert.Equal(t, "Storm", ladder[0].Nickname)
+	assert.Equal(t, int32(40), ladder[0].Points)
+	assert.Equal(t, int32(206), ladder[0].PointsDifference)
+
+	// There is no ladder after a round which has not been played
+	req, err = http.NewRequest("GET", "/api/v1/competitions/111/ladder?round=26", nil)
+	assert.NoError(t, err)
+
+	rr = httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "[]\n", rr.Body.String())
+}
+
+func TestGetCompetitionLadderInvalidRound(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/competitions/111/ladder?round=last", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetCompetitionRatingsAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/competitions/111/ratings", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Ratings are only calculated by the scheduled fetch and the backfill
+	var ratings []models.APITeamRating
+	err = json.Unmarshal(rr.Body.Bytes(), &ratings)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(ratings))
+}
+
+func TestGetCompetitionCalibrationAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/competitions/111/calibration?season=2024", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var calibration models.APICalibration
+	err = json.Unmarshal(rr.Body.Bytes(), &calibration)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(111), calibration.CompetitionID)
+	assert.Equal(t, int32(0), calibration.Model.Matches)
+	assert.Equal(t, 0, len(calibration.Buckets))
+}
+
+func TestGetTeamsAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/teams?competition_id=161", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var teams []models.APITeamDetails
+	err = json.Unmarshal(rr.Body.Bytes(), &teams)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(teams))
+
+	assert.Equal(t, "Sharks", teams[0].Nickname)
+	assert.Equal(t, 1, len(teams[0].Competitions))
+	assert.Equal(t, int64(161), teams[0].Competitions[0].CompetitionID)
+	assert.Equal(t, []int32{2024}, teams[0].Competitions[0].Seasons)
+}
+
+func TestGetTeamAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/teams/500012", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var team models.APITeamDetails
+	err = json.Unmarshal(rr.Body.Bytes(), &team)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Cowboys", team.Nickname)
+	assert.Equal(t, "North Queensland Toyota Cowboys", *team.Name)
+	assert.Equal(t, "NQL", *team.Code)
+	assert.Equal(t, "#002B5C", *team.PrimaryColour)
+	assert.Equal(t, "https://www.nrl.com/.theme/cowboys/badge.svg", *team.LogoURL)
+
+	assert.Equal(t, 1, len(team.HomeGrounds))
+	assert.Equal(t, "Queensland Country Bank Stadium", team.HomeGrounds[0].Venue)
+	assert.Equal(t, int32(1), team.HomeGrounds[0].Matches)
+}
+
+func TestGetTeamNotFound(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/teams/1", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGetHeadToHeadAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/teams/500012/versus/500021", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var headToHead models.APIHeadToHead
+	err = json.Unmarshal(rr.Body.Bytes(), &headToHead)
+	assert.NoError(t, err)
+
+	// The seeded match has not been played, so there are no past meetings
+	assert.Equal(t, "Cowboys", headToHead.Team)
+	assert.Equal(t, "Storm", headToHead.Opponent)
+	assert.Equal(t, int32(0), headToHead.Overall.Played)
+	assert.Equal(t, 0, len(headToHead.LastMeetings))
+}
+
+func TestGetHeadToHeadInvalidOpponent(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/teams/500012/versus/500012", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetHeadToHeadNotFound(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/teams/500012/versus/1", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGetNRLCacheMetricsAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/metrics/nrl-cache", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var stats models.APICacheStats
+	err = json.Unmarshal(rr.Body.Bytes(), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Requests)
+}
+
 func TestGetMatchDetailsAPI(t *testing.T) {
 	req, err := http.NewRequest("GET", "/api/v1/fixtures/111/20241112610", nil)
 	assert.NoError(t, err)
@@ -99,8 +382,88 @@ func TestGetMatchDetailsAPI(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, int64(20241112610), fixture.ID)
+	assert.Equal(t, int64(500012), fixture.HomeTeam.ID)
 	assert.Equal(t, "Cowboys", fixture.HomeTeam.Nickname)
 	assert.Equal(t, "Storm", fixture.AwayTeam.Nickname)
+	assert.Equal(t, "/api/v1/teams/500012/versus/500021", fixture.HeadToHeadURL)
+
+	// The odds imply the Cowboys are strong favourites, and have not moved since they were stored
+	if assert.NotNil(t, fixture.Market) {
+		assert.Equal(t, 0.776, fixture.Market.HomeProbability)
+		assert.Equal(t, 0.224, fixture.Market.AwayProbability)
+		assert.Equal(t, 0.048, fixture.Market.Overround)
+		assert.Equal(t, int64(500012), *fixture.Market.FavouriteTeamID)
+		assert.False(t, fixture.Market.CloseGame)
+		assert.Equal(t, 0.0, *fixture.Market.HomeDrift)
+	}
+
+	// Team lists are included with the match details
+	assert.Equal(t, 2, len(fixture.HomeTeam.Squad))
+	assert.Equal(t, "Scott Drinkwater", fixture.HomeTeam.Squad[0].Name)
+	assert.Equal(t, "Lock", fixture.HomeTeam.Squad[1].Position)
+	assert.Equal(t, 1, len(fixture.AwayTeam.Squad))
+	assert.Equal(t, 0, len(fixture.HomeTeam.LateChanges))
+}
+
+func TestGetMatchDetailsTimezoneAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/111/20241112610?tz=Pacific/Auckland", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var fixture models.APIFixture
+	err = json.Unmarshal(rr.Body.Bytes(), &fixture)
+	assert.NoError(t, err)
+
+	// Townsville does not observe daylight saving, New Zealand is on standard time in August
+	assert.Equal(t, "Australia/Brisbane", fixture.VenueTimezone)
+	assert.Equal(t, "2024-08-27T01:16:09Z", fixture.KickOffTime.Format(time.RFC3339))
+	assert.Equal(t, "2024-08-27T11:16:09+10:00", fixture.LocalKickOffTime.Format(time.RFC3339))
+	if assert.NotNil(t, fixture.ViewerKickOffTime) {
+		assert.Equal(t, "2024-08-27T13:16:09+12:00", fixture.ViewerKickOffTime.Format(time.RFC3339))
+	}
+}
+
+func TestGetFixturesInvalidTimezone(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/111?tz=Mars/Olympus_Mons", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetMatchStatsAPI(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/111/20241112610/stats", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var stats models.APIMatchStats
+	err = json.Unmarshal(rr.Body.Bytes(), &stats)
+	assert.NoError(t, err)
+
+	// The match has not been played, so there are no stats yet
+	assert.Equal(t, int64(20241112610), stats.FixtureID)
+	assert.Equal(t, 0, len(stats.Timeline))
+	assert.Equal(t, 0, len(stats.TeamStats))
+}
+
+func TestGetMatchStatsWrongCompetition(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/161/20241112610/stats", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
 }
 
 func TestGetCompetitionFixturesInvalidID(t *testing.T) {
@@ -113,6 +476,16 @@ func TestGetCompetitionFixturesInvalidID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rr.Code)
 }
 
+func TestGetCompetitionFixturesInvalidSeason(t *testing.T) {
+	req, err := http.NewRequest("GET", "/api/v1/fixtures/111?season=latest", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handlerRouter.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
 func TestGetMatchDetailsInvalidCompetitionID(t *testing.T) {
 	req, err := http.NewRequest("GET", "/api/v1/fixtures/999/20241112610", nil)
 	assert.NoError(t, err)
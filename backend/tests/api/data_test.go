@@ -1,7 +1,11 @@
 package api
 
 import (
+	"context"
+	"slices"
 	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/services"
 )
 
 func TestGetCompetitions(t *testing.T) {
@@ -25,7 +29,7 @@ func TestGetCompetitions(t *testing.T) {
 }
 
 func TestGetFixtures(t *testing.T) {
-	fixtures, err := dataService.GetFixtures()
+	fixtures, err := dataService.GetFixtures(nil)
 	if err != nil {
 		t.Fatalf("Failed to get fixtures: %v", err)
 	}
@@ -57,7 +61,7 @@ func TestGetFixtureByID(t *testing.T) {
 
 func TestGetCompetitionFixtures(t *testing.T) {
 	competitionID := int64(111) // NRL
-	fixtures, err := dataService.GetCompetitionFixtures(competitionID)
+	fixtures, err := dataService.GetCompetitionFixtures(competitionID, nil)
 	if err != nil {
 		t.Fatalf("Failed to get fixtures by competition ID: %v", err)
 	}
@@ -69,7 +73,7 @@ func TestGetCompetitionFixtures(t *testing.T) {
 
 func TestCompetitionsFixturesNRLW(t *testing.T) {
 	competitionID := int64(161) // NRLW
-	fixtures, err := dataService.GetCompetitionFixtures(competitionID)
+	fixtures, err := dataService.GetCompetitionFixtures(competitionID, nil)
 	if err != nil {
 		t.Fatalf("Failed to get fixtures by competition ID: %v", err)
 	}
@@ -78,3 +82,48 @@ func TestCompetitionsFixturesNRLW(t *testing.T) {
 		t.Fatalf("Expected 1 fixture, got %d", len(fixtures))
 	}
 }
+
+func TestGetFixturesBySeason(t *testing.T) {
+	season := int32(2024)
+	fixtures, err := dataService.GetFixtures(&season)
+	if err != nil {
+		t.Fatalf("Failed to get fixtures by season: %v", err)
+	}
+
+	if len(fixtures) != 3 {
+		t.Fatalf("Expected 3 fixtures, got %d", len(fixtures))
+	}
+
+	for _, fixture := range fixtures {
+		if fixture.Season != season {
+			t.Fatalf("Expected season %d, got %d", season, fixture.Season)
+		}
+	}
+
+	// There are no fixtures stored for the previous season
+	season = 2023
+	fixtures, err = dataService.GetCompetitionFixtures(111, &season)
+	if err != nil {
+		t.Fatalf("Failed to get fixtures by season: %v", err)
+	}
+
+	if len(fixtures) != 0 {
+		t.Fatalf("Expected 0 fixtures, got %d", len(fixtures))
+	}
+}
+
+func TestCheckLadder(t *testing.T) {
+	nrlDataService := services.NewNRLDataService(testQueries, context.Background())
+
+	// Only upcoming fixtures are stored, so none of the seeded ladder can be
+	// recalculated from results.
+	discrepancies, err := nrlDataService.CheckLadder(111, 2024, 25)
+	if err != nil {
+		t.Fatalf("Failed to check ladder: %v", err)
+	}
+
+	expected := "Storm wins is 18 on the ladder but 0 from results"
+	if !slices.Contains(discrepancies, expected) {
+		t.Fatalf("Expected discrepancy %q, got %v", expected, discrepancies)
+	}
+}
@@ -25,6 +25,16 @@ func seedDatabase() {
 	if err := addTitansVsSharksFixture(); err != nil { // This is NRLW
 		log.Fatalf("Failed to seed database: %v", err)
 	}
+
+	// Add a Ladder for the teams stored with the fixtures
+	if err := addRound25Ladder(); err != nil {
+		log.Fatalf("Failed to seed database: %v", err)
+	}
+
+	// Add a completed round of a previous season for the round kickoff windows
+	if err := addRound1Season2022Fixtures(); err != nil {
+		log.Fatalf("Failed to seed database: %v", err)
+	}
 }
 
 func addCowboysVsStormUpcomingFixture() error {
@@ -95,6 +105,26 @@ func addCowboysVsStormUpcomingFixture() error {
 				},
 			},
 		},
+		MatchCentre: &models.NRLMatchCentre{
+			HomeTeam: models.NRLTeamList{
+				TeamID: 500012,
+				Name:   "North Queensland Toyota Cowboys",
+				Theme: models.NRLTeamTheme{
+					Key:   "cowboys",
+					Logos: map[string]string{"badge.svg": "https://www.nrl.com/.theme/cowboys/badge.svg"},
+				},
+				Players: []models.NRLPlayer{
+					{ID: 500333, FirstName: "Scott", LastName: "Drinkwater", Number: 1, Position: "Fullback"},
+					{ID: 504279, FirstName: "Reuben", LastName: "Cotter", Number: 13, Position: "Lock"},
+				},
+			},
+			AwayTeam: models.NRLTeamList{
+				TeamID: 500021,
+				Players: []models.NRLPlayer{
+					{ID: 502209, FirstName: "Jahrome", LastName: "Hughes", Number: 7, Position: "Halfback"},
+				},
+			},
+		},
 	}
 
 	dataService := services.NewNRLDataService(testQueries, context.Background())
@@ -248,3 +278,79 @@ func addTitansVsSharksFixture() error {
 	dataService := services.NewNRLDataService(testQueries, context.Background())
 	return dataService.StoreFixtureAndDetails(fixture)
 }
+
+func addRound1Season2022Fixtures() error {
+	winnerScore, loserScore := 32, 6
+	fixtures := []models.NRLFixture{
+		{
+			ID:             "20221110110",
+			RoundTitle:     "Round 1",
+			MatchState:     "FullTime",
+			KickOffTime:    "2022-03-10T09:00:00Z",
+			Venue:          "Accor Stadium",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2022/round-1/rabbitohs-v-panthers/",
+			HomeTeam:       models.NRLTeam{ID: 500005, Name: "Rabbitohs", Score: &loserScore},
+			AwayTeam:       models.NRLTeam{ID: 500014, Name: "Panthers", Score: &winnerScore},
+		},
+		{
+			ID:             "20221110120",
+			RoundTitle:     "Round 1",
+			MatchState:     "FullTime",
+			KickOffTime:    "2022-03-13T06:05:00Z",
+			Venue:          "CommBank Stadium",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2022/round-1/eels-v-titans/",
+			HomeTeam:       models.NRLTeam{ID: 500031, Name: "Eels", Score: &winnerScore},
+			AwayTeam:       models.NRLTeam{ID: 500004, Name: "Titans", Score: &loserScore},
+		},
+	}
+
+	dataService := services.NewNRLDataService(testQueries, context.Background())
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addRound25Ladder() error {
+	ladder := models.NRLLadder{
+		SelectedSeasonID: 2024,
+		SelectedRoundID:  25,
+		Positions: []models.NRLLadderPosition{
+			{
+				TeamNickname: "Storm",
+				Stats: models.NRLLadderStats{
+					Played: 23, Wins: 18, Drawn: 0, Lost: 5, Byes: 2,
+					PointsFor: 614, PointsAgainst: 408, PointsDifference: 206, Points: 40,
+				},
+			},
+			{
+				TeamNickname: "Sea Eagles",
+				Stats: models.NRLLadderStats{
+					Played: 23, Wins: 13, Drawn: 1, Lost: 9, Byes: 2,
+					PointsFor: 610, PointsAgainst: 450, PointsDifference: 160, Points: 31,
+				},
+			},
+			{
+				TeamNickname: "Cowboys",
+				Stats: models.NRLLadderStats{
+					Played: 23, Wins: 14, Drawn: 0, Lost: 9, Byes: 2,
+					PointsFor: 592, PointsAgainst: 524, PointsDifference: 68, Points: 32,
+				},
+			},
+			{
+				TeamNickname: "Bulldogs",
+				Stats: models.NRLLadderStats{
+					Played: 23, Wins: 14, Drawn: 0, Lost: 9, Byes: 2,
+					PointsFor: 514, PointsAgainst: 404, PointsDifference: 110, Points: 32,
+				},
+			},
+		},
+	}
+
+	dataService := services.NewNRLDataService(testQueries, context.Background())
+	return dataService.StoreLadder(111, ladder)
+}
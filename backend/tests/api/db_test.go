@@ -75,7 +75,7 @@ func TestMain(m *testing.M) {
 
 	// Initialise handler router for testing the API requests
 	handlerRouter = http.NewServeMux()
-	handlers.RegisterRoutes(handlerRouter, dataService)
+	handlers.RegisterRoutes(handlerRouter, dataService, services.NewNRLCacheService(testQueries, context.Background()))
 
 	// Run tests
 	os.Exit(m.Run())
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestMarkBackfillRoundComplete(t *testing.T) {
+	ctx := context.Background()
+
+	arg := db.MarkBackfillRoundCompleteParams{
+		CompetitionID: 111,
+		Season:        2023,
+		RoundNumber:   1,
+		Fixtures:      8,
+	}
+
+	progress, err := testQueries.MarkBackfillRoundComplete(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to mark backfill round complete: %v", err)
+	}
+
+	if progress.Fixtures != arg.Fixtures {
+		t.Fatalf("Unexpected backfill progress: %+v", progress)
+	}
+
+	// Marking the same round again should replace the existing record
+	arg.Fixtures = 7
+	progress, err = testQueries.MarkBackfillRoundComplete(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to mark backfill round complete again: %v", err)
+	}
+
+	if progress.Fixtures != 7 {
+		t.Fatalf("Expected 7 fixtures, got %d", progress.Fixtures)
+	}
+}
+
+func TestGetBackfillProgress(t *testing.T) {
+	ctx := context.Background()
+
+	// Round 1 of 2023 was completed in the previous test
+	_, err := testQueries.GetBackfillProgress(ctx, db.GetBackfillProgressParams{
+		CompetitionID: 111,
+		Season:        2023,
+		RoundNumber:   1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get backfill progress: %v", err)
+	}
+
+	// Round 2 has not been imported
+	_, err = testQueries.GetBackfillProgress(ctx, db.GetBackfillProgressParams{
+		CompetitionID: 111,
+		Season:        2023,
+		RoundNumber:   2,
+	})
+	if err == nil {
+		t.Fatalf("Expected no backfill progress for round 2")
+	}
+
+	progress, err := testQueries.ListBackfillProgressByCompetitionID(ctx, 111)
+	if err != nil {
+		t.Fatalf("Failed to list backfill progress: %v", err)
+	}
+
+	if len(progress) != 1 {
+		t.Fatalf("Expected 1 completed round, got %d", len(progress))
+	}
+}
@@ -3,6 +3,8 @@ package db
 import (
 	"context"
 	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
 )
 
 func TestListCompetitions(t *testing.T) {
@@ -32,3 +34,42 @@ func TestGetCompetitionByID(t *testing.T) {
 		t.Fatalf("Expected competition ID %d, got %d", competitionID, competition.ID)
 	}
 }
+
+func TestUpsertCompetitionSeasonRound(t *testing.T) {
+	ctx := context.Background()
+
+	round := "Round 1"
+	arg := db.UpsertCompetitionSeasonRoundParams{
+		CompetitionID: 111,
+		Season:        2024,
+		Round:         &round,
+	}
+
+	season, err := testQueries.UpsertCompetitionSeasonRound(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to set competition season round: %v", err)
+	}
+
+	if *season.Round != round {
+		t.Fatalf("Expected round %s, got %s", round, *season.Round)
+	}
+
+	// Moving to the next round should update the existing season
+	round = "Round 2"
+	_, err = testQueries.UpsertCompetitionSeasonRound(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to update competition season round: %v", err)
+	}
+
+	season, err = testQueries.GetCompetitionSeason(ctx, db.GetCompetitionSeasonParams{
+		CompetitionID: 111,
+		Season:        2024,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get competition season: %v", err)
+	}
+
+	if *season.Round != "Round 2" {
+		t.Fatalf("Expected round Round 2, got %s", *season.Round)
+	}
+}
@@ -24,12 +24,13 @@ func TestCreateFixture(t *testing.T) {
 	arg := db.CreateFixtureParams{
 		ID:             1,
 		CompetitionID:  111,
+		Season:         2024,
 		Roundtitle:     "Round 1",
 		Matchstate:     "Upcoming",
 		Venue:          "Stadium A",
 		Venuecity:      "City A",
 		Matchcentreurl: "http://example.com/match/1",
-		Kickofftime:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+		Kickofftime:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
 	}
 
 	fixture, err := testQueries.CreateFixture(ctx, arg)
@@ -91,3 +92,55 @@ func TestUpdateFixture(t *testing.T) {
 		t.Fatalf("Expected updated round title 'Round 2', got '%s'", fixture.Roundtitle)
 	}
 }
+
+func TestCreateFixtureNonUTCSession(t *testing.T) {
+	ctx := context.Background()
+
+	// Use a session in a different time zone to the kickoff time
+	conn, err := testDB.Acquire(ctx)
+	if err != nil {
+		t.Fatalf("Failed to acquire connection: %v", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SET TIME ZONE 'Pacific/Auckland'"); err != nil {
+		t.Fatalf("Failed to set session time zone: %v", err)
+	}
+	defer conn.Exec(ctx, "RESET TIME ZONE")
+
+	brisbane, err := time.LoadLocation("Australia/Brisbane")
+	if err != nil {
+		t.Fatalf("Failed to load time zone: %v", err)
+	}
+	kickOffTime := time.Date(2024, time.August, 27, 11, 16, 9, 0, brisbane)
+
+	queries := db.New(conn)
+	_, err = queries.CreateFixture(ctx, db.CreateFixtureParams{
+		ID:             2,
+		CompetitionID:  111,
+		Season:         2024,
+		Roundtitle:     "Round 1",
+		Matchstate:     "Upcoming",
+		Venue:          "Stadium B",
+		Venuecity:      "City B",
+		Matchcentreurl: "http://example.com/match/2",
+		Kickofftime:    pgtype.Timestamptz{Time: kickOffTime, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create fixture: %v", err)
+	}
+
+	// Read it back on a connection in the default time zone
+	fixture, err := testQueries.GetFixtureByID(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get fixture by ID: %v", err)
+	}
+
+	if !fixture.Kickofftime.Time.Equal(kickOffTime) {
+		t.Fatalf("Expected kickoff time %v, got %v", kickOffTime, fixture.Kickofftime.Time)
+	}
+
+	if got := fixture.Kickofftime.Time.UTC().Format(time.RFC3339); got != "2024-08-27T01:16:09Z" {
+		t.Fatalf("Expected kickoff time 2024-08-27T01:16:09Z in UTC, got %s", got)
+	}
+}
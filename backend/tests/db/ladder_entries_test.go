@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestUpsertLadderEntry(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := testQueries.CreateTeam(ctx, db.CreateTeamParams{
+		ID:       500021,
+		Nickname: "Storm",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+
+	arg := db.UpsertLadderEntryParams{
+		CompetitionID:    111,
+		Season:           2023,
+		RoundNumber:      5,
+		TeamID:           500021,
+		Position:         2,
+		Played:           5,
+		Wins:             4,
+		Losses:           1,
+		PointsFor:        120,
+		PointsAgainst:    80,
+		PointsDifference: 40,
+		Points:           8,
+	}
+
+	entry, err := testQueries.UpsertLadderEntry(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to upsert ladder entry: %v", err)
+	}
+
+	if entry.Position != 2 || entry.Points != 8 {
+		t.Fatalf("Unexpected ladder entry data: %+v", entry)
+	}
+
+	// Upserting the same round again updates the entry
+	arg.Position = 1
+	entry, err = testQueries.UpsertLadderEntry(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to update ladder entry: %v", err)
+	}
+
+	if entry.Position != 1 {
+		t.Fatalf("Expected position 1, got %d", entry.Position)
+	}
+}
+
+func TestListLadderEntriesByCompetitionID(t *testing.T) {
+	ctx := context.Background()
+
+	// Defaults to the latest round of the latest season with a ladder
+	entries, err := testQueries.ListLadderEntriesByCompetitionID(ctx, db.ListLadderEntriesByCompetitionIDParams{
+		CompetitionID: 111,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list ladder entries: %v", err)
+	}
+
+	if len(entries) != 1 || entries[0].Team.Nickname != "Storm" {
+		t.Fatalf("Expected the Storm ladder entry, got %+v", entries)
+	}
+
+	round := int32(4)
+	entries, err = testQueries.ListLadderEntriesByCompetitionID(ctx, db.ListLadderEntriesByCompetitionIDParams{
+		CompetitionID: 111,
+		Round:         &round,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list ladder entries: %v", err)
+	}
+
+	if len(entries) != 0 {
+		t.Fatalf("Expected no ladder entries for round 4, got %d", len(entries))
+	}
+}
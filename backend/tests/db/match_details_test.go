@@ -14,9 +14,8 @@ func TestCreateMatchDetail(t *testing.T) {
 	odd2 := float64(2.49)
 
 	homeTeam := db.CreateTeamParams{
-		ID:            500001,
-		Nickname:      "homeTeam",
-		CompetitionID: 111,
+		ID:       500001,
+		Nickname: "homeTeam",
 	}
 
 	_, err := testQueries.CreateTeam(ctx, homeTeam)
@@ -25,9 +24,8 @@ func TestCreateMatchDetail(t *testing.T) {
 	}
 
 	awayTeam := db.CreateTeamParams{
-		ID:            500002,
-		Nickname:      "awayTeam",
-		CompetitionID: 111,
+		ID:       500002,
+		Nickname: "awayTeam",
 	}
 
 	_, err = testQueries.CreateTeam(ctx, awayTeam)
@@ -101,7 +99,7 @@ func TestGetMatchDetailsByFixtureID(t *testing.T) {
 func TestListMatchDetails(t *testing.T) {
 	ctx := context.Background()
 
-	_, err := testQueries.ListMatchDetails(ctx)
+	_, err := testQueries.ListMatchDetails(ctx, nil)
 	if err != nil {
 		t.Fatalf("Failed to list match details: %v", err)
 	}
@@ -111,7 +109,9 @@ func TestListMatchDetailsByCompetitionID(t *testing.T) {
 	ctx := context.Background()
 
 	competitionID := int64(111) // NRL
-	matchDetails, err := testQueries.ListMatchDetailsByCompetitionID(ctx, competitionID)
+	matchDetails, err := testQueries.ListMatchDetailsByCompetitionID(ctx, db.ListMatchDetailsByCompetitionIDParams{
+		CompetitionID: competitionID,
+	})
 	if err != nil {
 		t.Fatalf("Failed to list match details by competition ID: %v", err)
 	}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestUpsertNRLResponseCache(t *testing.T) {
+	ctx := context.Background()
+
+	etag := `"abc123"`
+	arg := db.UpsertNRLResponseCacheParams{
+		Url:         "https://www.nrl.com/draw/data?competition=111",
+		Etag:        &etag,
+		ContentHash: "0000000000000000000000000000000000000000000000000000000000000000",
+		Body:        []byte(`{"fixtures":[]}`),
+	}
+
+	cached, err := testQueries.UpsertNRLResponseCache(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to upsert NRL response cache: %v", err)
+	}
+
+	if cached.Url != arg.Url || *cached.Etag != etag {
+		t.Fatalf("Unexpected cached response: %+v", cached)
+	}
+
+	// Replace the cached response for the same URL
+	arg.Etag = nil
+	arg.Body = []byte(`{"fixtures":[{}]}`)
+
+	cached, err = testQueries.UpsertNRLResponseCache(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to replace NRL response cache: %v", err)
+	}
+
+	if cached.Etag != nil || string(cached.Body) != string(arg.Body) {
+		t.Fatalf("Expected cached response to be replaced, got %+v", cached)
+	}
+}
+
+func TestGetNRLResponseCache(t *testing.T) {
+	ctx := context.Background()
+
+	url := "https://www.nrl.com/draw/data?competition=111" // From the previous test
+	cached, err := testQueries.GetNRLResponseCache(ctx, url)
+	if err != nil {
+		t.Fatalf("Failed to get NRL response cache: %v", err)
+	}
+
+	if err := testQueries.TouchNRLResponseCache(ctx, url); err != nil {
+		t.Fatalf("Failed to touch NRL response cache: %v", err)
+	}
+
+	touched, err := testQueries.GetNRLResponseCache(ctx, url)
+	if err != nil {
+		t.Fatalf("Failed to get NRL response cache: %v", err)
+	}
+
+	if touched.FetchedAt.Time.Before(cached.FetchedAt.Time) {
+		t.Fatalf("Expected fetched_at to move forward, got %v before %v", touched.FetchedAt.Time, cached.FetchedAt.Time)
+	}
+}
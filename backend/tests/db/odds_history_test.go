@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestCreateOddsHistory(t *testing.T) {
+	ctx := context.Background()
+
+	// Assuming a fixture with ID 1 exists
+	for _, odds := range [][2]float64{{1.80, 2.00}, {1.65, 2.20}} {
+		_, err := testQueries.CreateOddsHistory(ctx, db.CreateOddsHistoryParams{
+			FixtureID:    1,
+			HometeamOdds: odds[0],
+			AwayteamOdds: odds[1],
+		})
+		if err != nil {
+			t.Fatalf("Failed to create odds history: %v", err)
+		}
+	}
+
+	latest, err := testQueries.GetLatestOddsByFixtureID(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get latest odds: %v", err)
+	}
+
+	if latest.HometeamOdds != 1.65 || latest.AwayteamOdds != 2.20 {
+		t.Fatalf("Expected the latest odds, got %+v", latest)
+	}
+
+	opening, err := testQueries.ListOpeningOddsByFixtureIDs(ctx, []int64{1})
+	if err != nil {
+		t.Fatalf("Failed to list opening odds: %v", err)
+	}
+
+	if len(opening) != 1 || opening[0].HometeamOdds != 1.80 {
+		t.Fatalf("Expected the opening odds, got %+v", opening)
+	}
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestCreateResultCorrection(t *testing.T) {
+	ctx := context.Background()
+
+	// Assuming a fixture with ID 1 exists
+	correction, err := testQueries.CreateResultCorrection(ctx, db.CreateResultCorrectionParams{
+		FixtureID:             1,
+		PreviousHometeamScore: 18,
+		PreviousAwayteamScore: 12,
+		HometeamScore:         18,
+		AwayteamScore:         20,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create result correction: %v", err)
+	}
+
+	corrections, err := testQueries.ListResultCorrectionsByFixtureID(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to list result corrections: %v", err)
+	}
+
+	if len(corrections) != 1 || corrections[0].ID != correction.ID || corrections[0].AwayteamScore != 20 {
+		t.Fatalf("Expected the result correction, got %+v", corrections)
+	}
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestUpsertRound(t *testing.T) {
+	ctx := context.Background()
+
+	arg := db.UpsertRoundParams{
+		CompetitionID: 161,
+		Season:        2024,
+		Number:        1,
+		Title:         "Round 1",
+		Slug:          "round-1",
+		Type:          "Regular",
+		PointsWeight:  1,
+	}
+
+	round, err := testQueries.UpsertRound(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to upsert round: %v", err)
+	}
+
+	if round.Title != arg.Title || round.FirstKickoff.Valid {
+		t.Fatalf("Unexpected round data: %+v", round)
+	}
+
+	// A round without fixtures has no kickoff window
+	round, err = testQueries.RefreshRoundKickOffs(ctx, db.RefreshRoundKickOffsParams{
+		CompetitionID: 161,
+		Season:        2024,
+		Number:        1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to refresh round kickoffs: %v", err)
+	}
+
+	if round.FirstKickoff.Valid || round.LastKickoff.Valid {
+		t.Fatalf("Expected no kickoff window, got %+v", round)
+	}
+}
+
+func TestRoundByes(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := testQueries.CreateTeam(ctx, db.CreateTeamParams{
+		ID:       500690,
+		Nickname: "Titans",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+
+	// Round 1 of 2024 was created in the previous test
+	err = testQueries.CreateRoundBye(ctx, db.CreateRoundByeParams{
+		CompetitionID: 161,
+		Season:        2024,
+		RoundNumber:   1,
+		TeamID:        500690,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create round bye: %v", err)
+	}
+
+	byes, err := testQueries.ListRoundByesByCompetitionID(ctx, db.ListRoundByesByCompetitionIDParams{
+		CompetitionID: 161,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list round byes: %v", err)
+	}
+
+	if len(byes) != 1 || byes[0].Team.Nickname != "Titans" {
+		t.Fatalf("Expected Titans on the bye, got %+v", byes)
+	}
+
+	err = testQueries.DeleteRoundByes(ctx, db.DeleteRoundByesParams{
+		CompetitionID: 161,
+		Season:        2024,
+		RoundNumber:   1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to delete round byes: %v", err)
+	}
+
+	rounds, err := testQueries.ListRoundsByCompetitionID(ctx, db.ListRoundsByCompetitionIDParams{
+		CompetitionID: 161,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list rounds: %v", err)
+	}
+
+	if len(rounds) != 1 {
+		t.Fatalf("Expected 1 round, got %d", len(rounds))
+	}
+}
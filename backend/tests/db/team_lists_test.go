@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestUpsertPlayer(t *testing.T) {
+	ctx := context.Background()
+
+	arg := db.UpsertPlayerParams{
+		ID:        504279,
+		FirstName: "Reuben",
+		LastName:  "Cotter",
+	}
+
+	player, err := testQueries.UpsertPlayer(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to upsert player: %v", err)
+	}
+
+	if player.ID != arg.ID || player.LastName != arg.LastName {
+		t.Fatalf("Unexpected player data: %+v", player)
+	}
+
+	// Upserting an existing player updates their name
+	arg.FirstName = "Reu"
+	player, err = testQueries.UpsertPlayer(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to update player: %v", err)
+	}
+
+	if player.FirstName != "Reu" {
+		t.Fatalf("Expected first name Reu, got %s", player.FirstName)
+	}
+}
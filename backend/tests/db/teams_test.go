@@ -20,9 +20,8 @@ func TestCreateTeam(t *testing.T) {
 	ctx := context.Background()
 
 	arg := db.CreateTeamParams{
-		ID:            500012,
-		Nickname:      "Cowboys",
-		CompetitionID: 111,
+		ID:       500012,
+		Nickname: "Cowboys",
 	}
 
 	team, err := testQueries.CreateTeam(ctx, arg)
@@ -48,3 +47,71 @@ func TestGetTeamByID(t *testing.T) {
 		t.Fatalf("Expected team ID %d, got %d", teamID, team.ID)
 	}
 }
+
+func TestUpsertTeam(t *testing.T) {
+	ctx := context.Background()
+
+	name, code := "North Queensland Toyota Cowboys", "NQL"
+	team, err := testQueries.UpsertTeam(ctx, db.UpsertTeamParams{
+		ID:       500012,
+		Nickname: "Cowboys",
+		Name:     &name,
+		Code:     &code,
+	})
+	if err != nil {
+		t.Fatalf("Failed to upsert team: %v", err)
+	}
+
+	if team.Name == nil || *team.Name != name {
+		t.Fatalf("Unexpected team data: %+v", team)
+	}
+
+	// Details which are not given keep their existing value
+	team, err = testQueries.UpsertTeam(ctx, db.UpsertTeamParams{
+		ID:       500012,
+		Nickname: "Cowboys",
+	})
+	if err != nil {
+		t.Fatalf("Failed to upsert team: %v", err)
+	}
+
+	if team.Code == nil || *team.Code != code {
+		t.Fatalf("Expected team code to be kept, got %+v", team)
+	}
+}
+
+func TestAddTeamCompetition(t *testing.T) {
+	ctx := context.Background()
+
+	for _, season := range []int32{2023, 2024, 2024} {
+		err := testQueries.AddTeamCompetition(ctx, db.AddTeamCompetitionParams{
+			TeamID:        500012,
+			CompetitionID: 111,
+			Season:        season,
+		})
+		if err != nil {
+			t.Fatalf("Failed to add team competition: %v", err)
+		}
+	}
+
+	memberships, err := testQueries.ListTeamCompetitionsByTeamID(ctx, 500012)
+	if err != nil {
+		t.Fatalf("Failed to list team competitions: %v", err)
+	}
+
+	if len(memberships) != 2 {
+		t.Fatalf("Expected 2 team competitions, got %d", len(memberships))
+	}
+
+	team, err := testQueries.GetTeamByNickname(ctx, db.GetTeamByNicknameParams{
+		Nickname:      "Cowboys",
+		CompetitionID: 111,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get team by nickname: %v", err)
+	}
+
+	if team.ID != 500012 {
+		t.Fatalf("Expected team ID 500012, got %d", team.ID)
+	}
+}
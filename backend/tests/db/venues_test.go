@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
+)
+
+func TestUpsertVenue(t *testing.T) {
+	ctx := context.Background()
+
+	state, country, timezone := "WA", "Australia", "Australia/Perth"
+	arg := db.UpsertVenueParams{
+		Name:     "Optus Stadium",
+		City:     "Perth",
+		State:    &state,
+		Country:  &country,
+		Timezone: &timezone,
+	}
+
+	venue, err := testQueries.UpsertVenue(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to upsert venue: %v", err)
+	}
+
+	if venue.Timezone == nil || *venue.Timezone != "Australia/Perth" || venue.State == nil || *venue.State != "WA" {
+		t.Fatalf("Unexpected venue data: %+v", venue)
+	}
+
+	// Upserting an existing venue updates its location
+	sydney := "Australia/Sydney"
+	arg.Timezone = &sydney
+	existing, err := testQueries.UpsertVenue(ctx, arg)
+	if err != nil {
+		t.Fatalf("Failed to upsert existing venue: %v", err)
+	}
+
+	if existing.ID != venue.ID || existing.Timezone == nil || *existing.Timezone != "Australia/Sydney" {
+		t.Fatalf("Expected the updated venue, got %+v", existing)
+	}
+
+	fetched, err := testQueries.GetVenueByID(ctx, venue.ID)
+	if err != nil {
+		t.Fatalf("Failed to get venue: %v", err)
+	}
+
+	if fetched.Name != "Optus Stadium" || fetched.City != "Perth" {
+		t.Fatalf("Unexpected venue data: %+v", fetched)
+	}
+}
@@ -1,7 +1,10 @@
 package nrl
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
@@ -108,3 +111,141 @@ func TestNRLWRound5Season2024(t *testing.T) {
 	assert.Equal(t, string(expectedStr), string(actualStr))
 
 }
+
+func TestDecodeMatchStatValues(t *testing.T) {
+	body := `{"title": "Completion Rate", "homeValue": {"value": 81}, "awayValue": 76}`
+
+	var stat models.NRLMatchStat
+	err := json.Unmarshal([]byte(body), &stat)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 81.0, *stat.HomeValue.Value)
+	assert.Equal(t, 76.0, *stat.AwayValue.Value)
+
+	// Values which are not numbers are left unset
+	err = json.Unmarshal([]byte(`{"homeValue": {"value": "7/9"}, "awayValue": "-"}`), &stat)
+	assert.NoError(t, err)
+	assert.Nil(t, stat.AwayValue.Value)
+}
+
+func TestFetchDrawInvalidatesOnMatchCentreFailure(t *testing.T) {
+	ctx := context.Background()
+
+	draw := `{"selectedSeasonId": 2015, "selectedRoundId": 1, "fixtures": [{"matchId": "20151110110", "matchState": "Upcoming", "matchCentreURL": "/draw/nrl-premiership/2015/round-1/kites-v-gulls/"}]}`
+	matchCentreUp := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/draw/data" {
+			w.Write([]byte(draw))
+			return
+		}
+		if !matchCentreUp {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"matchId": "20151110110", "matchState": "Upcoming", "startTime": "2015-03-05T08:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	cache := services.NewNRLCacheService(testQueries, ctx)
+	c := services.NewCachedNRLService(server.URL, cache)
+
+	// The draw is fetched but the match centre is not
+	_, err := c.FetchDraw(111, 1, 2015)
+	assert.Error(t, err)
+	assert.Nil(t, cache.Get(server.URL+"/draw/data?competition=111&round=1&season=2015"))
+
+	// The unchanged draw is still treated as modified once the match centre is back
+	matchCentreUp = true
+	response, err := c.FetchDraw(111, 1, 2015)
+	if err != nil {
+		t.Fatalf("Failed to fetch draw: %v", err)
+	}
+	assert.False(t, response.NotModified)
+	assert.False(t, response.Fixtures[0].NotModified)
+}
+
+func TestFetchDrawCachedOnceCommitted(t *testing.T) {
+	ctx := context.Background()
+
+	matchCentreURL := "/draw/nrl-premiership/2015/round-2/kites-v-gulls/"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/draw/data" {
+			w.Write([]byte(`{"selectedSeasonId": 2015, "selectedRoundId": 2, "fixtures": [{"matchId": "20151110210", "matchState": "FullTime", "matchCentreURL": "` + matchCentreURL + `"}]}`))
+			return
+		}
+		w.Write([]byte(`{"matchId": "20151110210", "matchState": "FullTime", "startTime": "2015-03-12T08:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	cache := services.NewNRLCacheService(testQueries, ctx)
+	c := services.NewCachedNRLService(server.URL, cache)
+
+	// Fetched documents are not cached until they are committed, so a fixture
+	// which was never stored is fetched as modified again
+	for i := 0; i < 2; i++ {
+		response, err := c.FetchDraw(111, 2, 2015)
+		if err != nil {
+			t.Fatalf("Failed to fetch draw: %v", err)
+		}
+		assert.False(t, response.NotModified)
+		assert.False(t, response.Fixtures[0].NotModified)
+	}
+	assert.Nil(t, cache.Get(server.URL+"/draw/data?competition=111&round=2&season=2015"))
+
+	// Once committed after the fixture is stored, the documents are unchanged
+	c.CommitMatchDetail(matchCentreURL)
+	c.CommitDraw(111, 2, 2015)
+
+	response, err := c.FetchDraw(111, 2, 2015)
+	if err != nil {
+		t.Fatalf("Failed to fetch draw: %v", err)
+	}
+	assert.True(t, response.NotModified)
+	assert.True(t, response.Fixtures[0].NotModified)
+
+	// An invalidated draw is processed again, such as when its byes were not stored
+	c.InvalidateDraw(111, 2, 2015)
+
+	response, err = c.FetchDraw(111, 2, 2015)
+	if err != nil {
+		t.Fatalf("Failed to fetch draw: %v", err)
+	}
+	assert.False(t, response.NotModified)
+	assert.False(t, response.Fixtures[0].NotModified)
+}
+
+func TestFetchLadderInvalidated(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"selectedSeasonId": 2015, "selectedRoundId": 3, "positions": []}`))
+	}))
+	defer server.Close()
+
+	cache := services.NewNRLCacheService(testQueries, ctx)
+	c := services.NewCachedNRLService(server.URL, cache)
+
+	ladder, err := c.FetchLadder(111, 0, 2015)
+	if err != nil {
+		t.Fatalf("Failed to fetch ladder: %v", err)
+	}
+	assert.False(t, ladder.NotModified)
+
+	// A committed ladder is unchanged on the next fetch
+	c.CommitLadder(111, 0, 2015)
+
+	ladder, err = c.FetchLadder(111, 0, 2015)
+	if err != nil {
+		t.Fatalf("Failed to fetch ladder: %v", err)
+	}
+	assert.True(t, ladder.NotModified)
+
+	// An invalidated ladder, such as one that could not be stored, is processed again
+	c.InvalidateLadder(111, 0, 2015)
+
+	ladder, err = c.FetchLadder(111, 0, 2015)
+	if err != nil {
+		t.Fatalf("Failed to fetch ladder: %v", err)
+	}
+	assert.False(t, ladder.NotModified)
+}
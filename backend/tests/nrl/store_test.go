@@ -2,11 +2,16 @@ package nrl
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/aussiebroadwan/tipping/backend/config"
+	"github.com/aussiebroadwan/tipping/backend/internal/db"
 	"github.com/aussiebroadwan/tipping/backend/internal/models"
 	"github.com/aussiebroadwan/tipping/backend/internal/services"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -82,7 +87,7 @@ func TestStoreAndFetchNRLRound22Season2024(t *testing.T) {
 	assert.Equal(t, *parseScore(expected.HomeTeam.Score), *storedMatchDetails.MatchDetail.HometeamScore)
 	assert.Equal(t, *parseScore(expected.AwayTeam.Score), *storedMatchDetails.MatchDetail.AwayteamScore)
 
-	assert.Equal(t, expected.KickOffTime, storedFixture.Kickofftime.Time.Format("2006-01-02T15:04:05Z"))
+	assert.Equal(t, expected.KickOffTime, storedFixture.Kickofftime.Time.UTC().Format(time.RFC3339))
 }
 
 func TestStoreAndFetchNRLWRound5Season2024(t *testing.T) {
@@ -157,7 +162,1116 @@ func TestStoreAndFetchNRLWRound5Season2024(t *testing.T) {
 	assert.Equal(t, *parseScore(expected.HomeTeam.Score), *storedMatchDetails.MatchDetail.HometeamScore)
 	assert.Equal(t, *parseScore(expected.AwayTeam.Score), *storedMatchDetails.MatchDetail.AwayteamScore)
 
-	assert.Equal(t, expected.KickOffTime, storedFixture.Kickofftime.Time.Format("2006-01-02T15:04:05Z"))
+	assert.Equal(t, expected.KickOffTime, storedFixture.Kickofftime.Time.UTC().Format(time.RFC3339))
+}
+
+func TestStoreFinalsRounds(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	fixtures := []models.NRLFixture{
+		{
+			ID:             "20231113010",
+			RoundTitle:     "Finals Week 3",
+			MatchState:     "FullTime",
+			KickOffTime:    "2023-09-22T09:50:00Z",
+			Venue:          "Accor Stadium",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2023/finals-week-3/panthers-v-storm/",
+			HomeTeam:       models.NRLTeam{ID: 500014, Name: "Panthers"},
+			AwayTeam:       models.NRLTeam{ID: 500021, Name: "Storm"},
+		},
+		{
+			ID:             "20231113110",
+			RoundTitle:     "Grand Final",
+			MatchState:     "FullTime",
+			KickOffTime:    "2023-10-01T08:30:00Z",
+			Venue:          "Accor Stadium",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2023/grand-final/panthers-v-broncos/",
+			HomeTeam:       models.NRLTeam{ID: 500014, Name: "Panthers"},
+			AwayTeam:       models.NRLTeam{ID: 500011, Name: "Broncos"},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store finals fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	season := int32(2023)
+	rounds, err := testQueries.ListRoundsByCompetitionID(ctx, db.ListRoundsByCompetitionIDParams{
+		CompetitionID: 111,
+		Season:        &season,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list rounds: %v", err)
+	}
+
+	assert.Equal(t, 2, len(rounds))
+
+	assert.Equal(t, "finals-week-3", rounds[0].Slug)
+	assert.Equal(t, "Finals", rounds[0].Type)
+	assert.Equal(t, int32(3), *rounds[0].FinalsWeek)
+	assert.Equal(t, int32(2), rounds[0].PointsWeight)
+
+	// The grand final follows on from the last finals week
+	assert.Equal(t, "grand-final", rounds[1].Slug)
+	assert.Equal(t, "Finals", rounds[1].Type)
+	assert.Equal(t, int32(4), *rounds[1].FinalsWeek)
+	assert.Equal(t, int32(3), rounds[1].PointsWeight)
+}
+
+func TestDefaultSeasonIsCurrentRoundSeason(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	// The draw of a later season is stored before it becomes the current season
+	fixtures := []models.NRLFixture{
+		{
+			ID:             "20231610110",
+			RoundTitle:     "Round 1",
+			MatchState:     "Upcoming",
+			KickOffTime:    "2023-07-22T05:00:00Z",
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrlw/2023/round-1/jets-v-bears/",
+			IsCurrentRound: true,
+			HomeTeam:       models.NRLTeam{ID: 600091, Name: "Jets"},
+			AwayTeam:       models.NRLTeam{ID: 600092, Name: "Bears"},
+		},
+		{
+			ID:             "20971610110",
+			RoundTitle:     "Round 1",
+			MatchState:     "Upcoming",
+			KickOffTime:    "2097-07-20T05:00:00Z",
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrlw/2097/round-1/jets-v-bears/",
+			HomeTeam:       models.NRLTeam{ID: 600091, Name: "Jets"},
+			AwayTeam:       models.NRLTeam{ID: 600092, Name: "Bears"},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	// Without a season, the season of the current round is used
+	apiFixtures, err := apiDataService.GetCompetitionFixtures(161, nil)
+	if err != nil {
+		t.Fatalf("Failed to get fixtures: %v", err)
+	}
+	if assert.NotEmpty(t, apiFixtures) {
+		for _, f := range apiFixtures {
+			assert.Equal(t, int32(2023), f.Season)
+		}
+	}
+
+	rounds, err := apiDataService.GetCompetitionRounds(161, nil)
+	if err != nil {
+		t.Fatalf("Failed to get rounds: %v", err)
+	}
+	if assert.Equal(t, 1, len(rounds)) && assert.NotNil(t, rounds[0].FirstKickOff) {
+		assert.Equal(t, 2023, rounds[0].FirstKickOff.Year())
+	}
+}
+
+func TestRefreshRoundPointsWeights(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	// A grand final stored with the wrong weight
+	_, err := testQueries.UpsertRound(ctx, db.UpsertRoundParams{
+		CompetitionID: 111,
+		Season:        2013,
+		Number:        30,
+		Title:         "Grand Final",
+		Slug:          "grand-final",
+		Type:          "Finals",
+		PointsWeight:  1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to store round: %v", err)
+	}
+
+	updated, err := dataService.RefreshRoundPointsWeights()
+	if err != nil {
+		t.Fatalf("Failed to refresh round points weights: %v", err)
+	}
+	assert.GreaterOrEqual(t, updated, 1)
+
+	season := int32(2013)
+	rounds, err := testQueries.ListRoundsByCompetitionID(ctx, db.ListRoundsByCompetitionIDParams{
+		CompetitionID: 111,
+		Season:        &season,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list rounds: %v", err)
+	}
+
+	if assert.Equal(t, 1, len(rounds)) {
+		assert.Equal(t, int32(3), rounds[0].PointsWeight)
+	}
+}
+
+func TestStoreKickOffTimeNonUTC(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	// Kickoff times must be the same instant regardless of the local time zone
+	adelaide, err := time.LoadLocation("Australia/Adelaide")
+	if err != nil {
+		t.Fatalf("Failed to load time zone: %v", err)
+	}
+	local := time.Local
+	time.Local = adelaide
+	t.Cleanup(func() { time.Local = local })
+
+	fixture := models.NRLFixture{
+		ID:             "20211110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "FullTime",
+		KickOffTime:    "2021-03-11T09:05:00Z",
+		Venue:          "Suncorp Stadium",
+		VenueCity:      "Brisbane",
+		MatchCentreURL: "/draw/nrl-premiership/2021/round-1/broncos-v-panthers/",
+		HomeTeam:       models.NRLTeam{ID: 500011, Name: "Broncos"},
+		AwayTeam:       models.NRLTeam{ID: 500014, Name: "Panthers"},
+	}
+
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	storedFixture, err := testQueries.GetFixtureByID(ctx, parseFixtureID(fixture.ID))
+	if err != nil {
+		t.Fatalf("Failed to fetch stored fixture from the database: %v", err)
+	}
+
+	expected := time.Date(2021, time.March, 11, 9, 5, 0, 0, time.UTC)
+	assert.True(t, expected.Equal(storedFixture.Kickofftime.Time), "expected %v, got %v", expected, storedFixture.Kickofftime.Time)
+	assert.Equal(t, fixture.KickOffTime, storedFixture.Kickofftime.Time.UTC().Format(time.RFC3339))
+
+	season := int32(2021)
+	rounds, err := testQueries.ListRoundsByCompetitionID(ctx, db.ListRoundsByCompetitionIDParams{
+		CompetitionID: 111,
+		Season:        &season,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list rounds: %v", err)
+	}
+
+	if assert.Equal(t, 1, len(rounds)) {
+		assert.True(t, expected.Equal(rounds[0].FirstKickoff.Time))
+		assert.True(t, expected.Equal(rounds[0].LastKickoff.Time))
+	}
+}
+
+func TestStoreRescheduledFixture(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	fixture := models.NRLFixture{
+		ID:             "20141110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "Upcoming",
+		KickOffTime:    "2014-03-06T08:45:00Z",
+		Venue:          "Henson Park",
+		VenueCity:      "Sydney",
+		MatchCentreURL: "/draw/nrl-premiership/2014/round-1/herons-v-ibis/",
+		HomeTeam:       models.NRLTeam{ID: 600051, Name: "Herons"},
+		AwayTeam:       models.NRLTeam{ID: 600052, Name: "Ibis"},
+	}
+
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	// The match is moved to another day and venue
+	fixture.KickOffTime = "2014-03-08T06:30:00Z"
+	fixture.Venue, fixture.VenueCity = "North Sydney Oval", "North Sydney"
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	storedFixture, err := testQueries.GetFixtureByID(ctx, parseFixtureID(fixture.ID))
+	if err != nil {
+		t.Fatalf("Failed to fetch stored fixture from the database: %v", err)
+	}
+
+	expected := time.Date(2014, time.March, 8, 6, 30, 0, 0, time.UTC)
+	assert.True(t, expected.Equal(storedFixture.Kickofftime.Time), "expected %v, got %v", expected, storedFixture.Kickofftime.Time)
+	assert.Equal(t, "North Sydney Oval", storedFixture.Venue)
+	assert.Equal(t, "North Sydney", storedFixture.Venuecity)
+
+	season := int32(2014)
+	rounds, err := testQueries.ListRoundsByCompetitionID(ctx, db.ListRoundsByCompetitionIDParams{
+		CompetitionID: 111,
+		Season:        &season,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list rounds: %v", err)
+	}
+
+	if assert.Equal(t, 1, len(rounds)) {
+		assert.True(t, expected.Equal(rounds[0].FirstKickoff.Time))
+		assert.True(t, expected.Equal(rounds[0].LastKickoff.Time))
+	}
+}
+
+func TestRefreshVenues(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	// A fixture stored before venues were tracked, in a city with no location
+	_, err := testQueries.CreateFixture(ctx, db.CreateFixtureParams{
+		ID:             20131110110,
+		CompetitionID:  111,
+		Season:         2013,
+		RoundNumber:    1,
+		Roundtitle:     "Round 1",
+		Matchstate:     "FullTime",
+		Venue:          "Carrara Stadium",
+		Venuecity:      "Carrara",
+		Matchcentreurl: "/draw/nrl-premiership/2013/round-1/titans-v-knights/",
+		Kickofftime:    pgtype.Timestamptz{Time: time.Date(2013, time.March, 9, 8, 0, 0, 0, time.UTC), Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create fixture: %v", err)
+	}
+
+	linked, err := dataService.RefreshVenues()
+	if err != nil {
+		t.Fatalf("Failed to refresh venues: %v", err)
+	}
+	assert.GreaterOrEqual(t, linked, int64(1))
+
+	fixture, err := testQueries.GetFixtureByID(ctx, 20131110110)
+	if err != nil {
+		t.Fatalf("Failed to get fixture: %v", err)
+	}
+	if !assert.NotNil(t, fixture.VenueID) {
+		return
+	}
+
+	venue, err := testQueries.GetVenueByID(ctx, *fixture.VenueID)
+	if err != nil {
+		t.Fatalf("Failed to get venue: %v", err)
+	}
+	// The venue city is not in config, so the venue has no timezone
+	assert.Nil(t, venue.Timezone)
+
+	// The venue is added to config
+	config.VenueLocationsByName["Carrara Stadium"] = config.VenueLocation{State: "QLD", Country: "Australia", Timezone: "Australia/Brisbane"}
+	t.Cleanup(func() { delete(config.VenueLocationsByName, "Carrara Stadium") })
+
+	if _, err := dataService.RefreshVenues(); err != nil {
+		t.Fatalf("Failed to refresh venues: %v", err)
+	}
+
+	venue, err = testQueries.GetVenueByID(ctx, *fixture.VenueID)
+	if err != nil {
+		t.Fatalf("Failed to get venue: %v", err)
+	}
+	if assert.NotNil(t, venue.Timezone) {
+		assert.Equal(t, "Australia/Brisbane", *venue.Timezone)
+	}
+	assert.Equal(t, "QLD", *venue.State)
+}
+
+func TestStoreTeamListLateChanges(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	// The match must not have started for late changes to be tracked
+	fixture := models.NRLFixture{
+		ID:             "20991110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "Upcoming",
+		KickOffTime:    time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339),
+		Venue:          "Suncorp Stadium",
+		VenueCity:      "Brisbane",
+		MatchCentreURL: "/draw/nrl-premiership/2099/round-1/broncos-v-storm/",
+		HomeTeam:       models.NRLTeam{ID: 500011, Name: "Broncos"},
+		AwayTeam:       models.NRLTeam{ID: 500021, Name: "Storm"},
+		MatchCentre: &models.NRLMatchCentre{
+			HomeTeam: models.NRLTeamList{
+				TeamID: 500011,
+				Players: []models.NRLPlayer{
+					{ID: 510001, FirstName: "Reece", LastName: "Walsh", Number: 1, Position: "Fullback"},
+					{ID: 510002, FirstName: "Adam", LastName: "Reynolds", Number: 7, Position: "Halfback"},
+				},
+			},
+		},
+	}
+
+	// The team announcement is not a change
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	// The halfback is ruled out and replaced, and the fullback moves to the bench
+	fixture.MatchCentre.HomeTeam.Players = []models.NRLPlayer{
+		{ID: 510001, FirstName: "Reece", LastName: "Walsh", Number: 14, Position: "Interchange"},
+		{ID: 510003, FirstName: "Ezra", LastName: "Mam", Number: 7, Position: "Halfback"},
+	}
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	fixtureID := parseFixtureID(fixture.ID)
+
+	teamLists, err := testQueries.ListTeamListsByFixtureID(ctx, fixtureID)
+	if err != nil {
+		t.Fatalf("Failed to list team lists: %v", err)
+	}
+
+	assert.Equal(t, 2, len(teamLists))
+	assert.Equal(t, "Mam", teamLists[0].Player.LastName)
+	assert.Equal(t, int32(14), teamLists[1].TeamList.Number)
+
+	changes, err := testQueries.ListTeamListChangesByFixtureID(ctx, fixtureID)
+	if err != nil {
+		t.Fatalf("Failed to list team list changes: %v", err)
+	}
+
+	actual := make(map[string]string)
+	for _, c := range changes {
+		actual[c.Player.LastName] = c.TeamListChange.Change
+	}
+
+	assert.Equal(t, map[string]string{
+		"Walsh":    "Position",
+		"Mam":      "In",
+		"Reynolds": "Out",
+	}, actual)
+}
+
+func TestStoreMatchStats(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	homeScore, awayScore := 6, 0
+	completionRate, runMetres := 81.0, 1620.0
+
+	fixture := models.NRLFixture{
+		ID:             "20221110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "FullTime",
+		KickOffTime:    "2022-03-03T09:00:00Z",
+		Venue:          "Accor Stadium",
+		VenueCity:      "Sydney",
+		MatchCentreURL: "/draw/nrl-premiership/2022/round-1/panthers-v-broncos/",
+		HomeTeam:       models.NRLTeam{ID: 500014, Name: "Panthers", Score: &homeScore},
+		AwayTeam:       models.NRLTeam{ID: 500011, Name: "Broncos", Score: &awayScore},
+		MatchCentre: &models.NRLMatchCentre{
+			HomeTeam: models.NRLTeamList{
+				TeamID: 500014,
+				Players: []models.NRLPlayer{
+					{ID: 500180, FirstName: "Nathan", LastName: "Cleary", Number: 7, Position: "Halfback"},
+				},
+			},
+			Timeline: []models.NRLMatchEvent{
+				{Type: "Try", Title: "Try", GameSeconds: 1200, TeamID: 500014, PlayerID: 500180, HomeScore: ptr(4), AwayScore: ptr(0)},
+				{Type: "Goal", Title: "Conversion", GameSeconds: 1260, TeamID: 500014, PlayerID: 500180, HomeScore: ptr(6), AwayScore: ptr(0)},
+				{Type: "HalfTime", Title: "Half Time", GameSeconds: 2400},
+			},
+			Stats: models.NRLMatchStats{
+				Groups: []models.NRLMatchStatGroup{
+					{
+						Title: "Possession",
+						Stats: []models.NRLMatchStat{
+							{Title: "Completion Rate", HomeValue: models.NRLStatValue{Value: &completionRate}},
+						},
+					},
+					{
+						Title: "Attack",
+						Stats: []models.NRLMatchStat{
+							{Title: "All Run Metres", HomeValue: models.NRLStatValue{Value: &runMetres}, AwayValue: models.NRLStatValue{Value: &runMetres}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Storing twice replaces the timeline rather than adding to it
+	for i := 0; i < 2; i++ {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture: %v", err)
+		}
+	}
+
+	stats, err := apiDataService.GetFixtureStats(parseFixtureID(fixture.ID))
+	if err != nil {
+		t.Fatalf("Failed to get fixture stats: %v", err)
+	}
+
+	assert.Equal(t, 3, len(stats.Timeline))
+	assert.Equal(t, "Nathan Cleary", *stats.Timeline[0].Player)
+	assert.Equal(t, int32(6), *stats.Timeline[1].HomeScore)
+	assert.Nil(t, stats.Timeline[2].TeamID)
+
+	assert.Equal(t, 2, len(stats.TeamStats))
+	assert.Equal(t, "All Run Metres", stats.TeamStats[0].Name)
+	assert.Equal(t, runMetres, *stats.TeamStats[0].AwayValue)
+	assert.Equal(t, completionRate, *stats.TeamStats[1].HomeValue)
+	assert.Nil(t, stats.TeamStats[1].AwayValue)
+}
+
+func TestStoreResultCorrection(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+
+	fixture := models.NRLFixture{
+		ID:             "20161110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "Live",
+		KickOffTime:    "2016-03-03T08:50:00Z",
+		Venue:          "Henson Park",
+		VenueCity:      "Sydney",
+		MatchCentreURL: "/draw/nrl-premiership/2016/round-1/hawks-v-owls/",
+		HomeTeam:       models.NRLTeam{ID: 600041, Name: "Hawks", Score: ptr(12)},
+		AwayTeam:       models.NRLTeam{ID: 600042, Name: "Owls", Score: ptr(12)},
+	}
+
+	// Scores that change while the match is being played, or once it is first
+	// completed, are not corrections
+	store := func(state string, home, away int) {
+		fixture.MatchState = state
+		fixture.HomeTeam.Score, fixture.AwayTeam.Score = ptr(home), ptr(away)
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture: %v", err)
+		}
+	}
+	store("Live", 12, 12)
+	store("FullTime", 18, 12)
+	store("FullTime", 18, 12)
+
+	corrections, err := testQueries.ListResultCorrectionsByFixtureID(ctx, 20161110110)
+	if err != nil {
+		t.Fatalf("Failed to list result corrections: %v", err)
+	}
+	assert.Empty(t, corrections)
+
+	// The NRL amends the result after the match is complete
+	store("FullTime", 18, 20)
+
+	corrections, err = testQueries.ListResultCorrectionsByFixtureID(ctx, 20161110110)
+	if err != nil {
+		t.Fatalf("Failed to list result corrections: %v", err)
+	}
+	if assert.Equal(t, 1, len(corrections)) {
+		assert.Equal(t, int32(12), corrections[0].PreviousAwayteamScore)
+		assert.Equal(t, int32(20), corrections[0].AwayteamScore)
+	}
+
+	details, err := testQueries.GetMatchDetailsByFixtureID(ctx, 20161110110)
+	if err != nil {
+		t.Fatalf("Failed to get match details: %v", err)
+	}
+	assert.Equal(t, int32(20), *details.MatchDetail.AwayteamScore)
+	if assert.NotNil(t, details.MatchDetail.WinnerTeamid) {
+		assert.Equal(t, int64(600042), *details.MatchDetail.WinnerTeamid)
+	}
+
+	// A correction to a draw leaves the match without a winner
+	store("FullTime", 20, 20)
+
+	details, err = testQueries.GetMatchDetailsByFixtureID(ctx, 20161110110)
+	if err != nil {
+		t.Fatalf("Failed to get match details: %v", err)
+	}
+	assert.Equal(t, int32(20), *details.MatchDetail.HometeamScore)
+	assert.Nil(t, details.MatchDetail.WinnerTeamid)
+
+	// Scores stored once the match is checked at full time are also corrections
+	if err := dataService.UpdateMatchScores(fixture.ID, 600041, ptr(24), 600042, ptr(20)); err != nil {
+		t.Fatalf("Failed to update match scores: %v", err)
+	}
+
+	corrections, err = testQueries.ListResultCorrectionsByFixtureID(ctx, 20161110110)
+	if err != nil {
+		t.Fatalf("Failed to list result corrections: %v", err)
+	}
+	if assert.Equal(t, 3, len(corrections)) {
+		assert.Equal(t, int32(20), corrections[1].HometeamScore)
+		assert.Equal(t, int32(20), corrections[2].PreviousHometeamScore)
+		assert.Equal(t, int32(24), corrections[2].HometeamScore)
+	}
+
+	details, err = testQueries.GetMatchDetailsByFixtureID(ctx, 20161110110)
+	if err != nil {
+		t.Fatalf("Failed to get match details: %v", err)
+	}
+	if assert.NotNil(t, details.MatchDetail.WinnerTeamid) {
+		assert.Equal(t, int64(600041), *details.MatchDetail.WinnerTeamid)
+	}
+}
+
+func TestTeamFormFromResults(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	fixtures := []models.NRLFixture{
+		{
+			ID:             "20201110110",
+			RoundTitle:     "Round 1",
+			MatchState:     "FullTime",
+			KickOffTime:    "2020-03-12T09:00:00Z",
+			Venue:          "Suncorp Stadium",
+			VenueCity:      "Brisbane",
+			MatchCentreURL: "/draw/nrl-premiership/2020/round-1/broncos-v-panthers/",
+			HomeTeam:       models.NRLTeam{ID: 500011, Name: "Broncos", Score: ptr(20)},
+			AwayTeam:       models.NRLTeam{ID: 500014, Name: "Panthers", Score: ptr(10)},
+		},
+		{
+			ID:             "20201110210",
+			RoundTitle:     "Round 2",
+			MatchState:     "FullTime",
+			KickOffTime:    "2020-03-19T09:00:00Z",
+			Venue:          "AAMI Park",
+			VenueCity:      "Melbourne",
+			MatchCentreURL: "/draw/nrl-premiership/2020/round-2/storm-v-broncos/",
+			HomeTeam:       models.NRLTeam{ID: 500021, Name: "Storm", Score: ptr(18)},
+			AwayTeam:       models.NRLTeam{ID: 500011, Name: "Broncos", Score: ptr(18)},
+		},
+		{
+			ID:             "20201110310",
+			RoundTitle:     "Round 3",
+			MatchState:     "Upcoming",
+			KickOffTime:    "2020-03-26T09:00:00Z",
+			Venue:          "Suncorp Stadium",
+			VenueCity:      "Brisbane",
+			MatchCentreURL: "/draw/nrl-premiership/2020/round-3/broncos-v-panthers/",
+			HomeTeam:       models.NRLTeam{ID: 500011, Name: "Broncos"},
+			AwayTeam:       models.NRLTeam{ID: 500014, Name: "Panthers"},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	fixture, err := apiDataService.GetFixtureDetails(20201110310)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+
+	// The Broncos won at home then drew away, most recent result first
+	homeForm := fixture.HomeTeam.FormDetail
+	if assert.NotNil(t, homeForm) && assert.Equal(t, 2, len(homeForm.Results)) {
+		assert.Equal(t, "D", homeForm.Results[0].Result)
+		assert.Equal(t, "Storm", homeForm.Results[0].Opponent)
+		assert.False(t, homeForm.Results[0].Home)
+		assert.Equal(t, "W", homeForm.Results[1].Result)
+		assert.Equal(t, int32(20), homeForm.Results[1].Score)
+		assert.Equal(t, int32(10), homeForm.Results[1].OpponentScore)
+	}
+	assert.Equal(t, int32(1), homeForm.Wins)
+	assert.Equal(t, int32(1), homeForm.Draws)
+	assert.Equal(t, int32(38), homeForm.PointsFor)
+	assert.Equal(t, int32(28), homeForm.PointsAgainst)
+	assert.Equal(t, models.APIFormSplit{Played: 1, Wins: 1, PointsFor: 20, PointsAgainst: 10}, homeForm.Home)
+	assert.Equal(t, models.APIFormSplit{Played: 1, Draws: 1, PointsFor: 18, PointsAgainst: 18}, homeForm.Away)
+
+	// The Panthers have only lost away, and later matches are not included
+	awayForm := fixture.AwayTeam.FormDetail
+	if assert.NotNil(t, awayForm) && assert.Equal(t, 1, len(awayForm.Results)) {
+		assert.Equal(t, "L", awayForm.Results[0].Result)
+		assert.Equal(t, int64(20201110110), awayForm.Results[0].FixtureID)
+	}
+	assert.Equal(t, int32(1), awayForm.Losses)
+	assert.Equal(t, int32(0), awayForm.Home.Played)
+}
+
+func TestTeamFormLimitedToRecentResults(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	// More completed rounds than are included in the form, then an upcoming one
+	rounds := config.FormMatches + 2
+	for round := 1; round <= rounds; round++ {
+		fixture := models.NRLFixture{
+			ID:             fmt.Sprintf("2012111%02d10", round),
+			RoundTitle:     fmt.Sprintf("Round %d", round),
+			MatchState:     "FullTime",
+			KickOffTime:    time.Date(2012, 3, 1+7*round, 9, 0, 0, 0, time.UTC).Format(time.RFC3339),
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: fmt.Sprintf("/draw/nrl-premiership/2012/round-%d/jets-v-bears/", round),
+			HomeTeam:       models.NRLTeam{ID: 600061, Name: "Jets", Score: ptr(20 + round)},
+			AwayTeam:       models.NRLTeam{ID: 600062, Name: "Bears", Score: ptr(10)},
+		}
+		if round == rounds {
+			fixture.MatchState = "Upcoming"
+			fixture.HomeTeam.Score, fixture.AwayTeam.Score = nil, nil
+		}
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	fixture, err := apiDataService.GetFixtureDetails(int64(20121110010 + 100*rounds))
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+
+	// Only the most recent results are included, newest first
+	homeForm := fixture.HomeTeam.FormDetail
+	if assert.NotNil(t, homeForm) && assert.Equal(t, config.FormMatches, len(homeForm.Results)) {
+		assert.Equal(t, int32(20+rounds-1), homeForm.Results[0].Score)
+		assert.Equal(t, int32(20+rounds-config.FormMatches), homeForm.Results[config.FormMatches-1].Score)
+	}
+	assert.Equal(t, int32(config.FormMatches), homeForm.Wins)
+	assert.Equal(t, int32(config.FormMatches), fixture.AwayTeam.FormDetail.Losses)
+}
+
+func TestHeadToHeadFromResults(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	// Teams that only play each other, so no other stored results are included
+	fixtures := []models.NRLFixture{
+		{
+			ID:             "20191110110",
+			RoundTitle:     "Round 1",
+			MatchState:     "FullTime",
+			KickOffTime:    "2019-03-14T08:50:00Z",
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2019/round-1/jets-v-bears/",
+			HomeTeam:       models.NRLTeam{ID: 600001, Name: "Jets", Score: ptr(24)},
+			AwayTeam:       models.NRLTeam{ID: 600002, Name: "Bears", Score: ptr(12)},
+		},
+		{
+			ID:             "20191110210",
+			RoundTitle:     "Round 2",
+			MatchState:     "FullTime",
+			KickOffTime:    "2019-03-21T08:50:00Z",
+			Venue:          "North Sydney Oval",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2019/round-2/bears-v-jets/",
+			HomeTeam:       models.NRLTeam{ID: 600002, Name: "Bears", Score: ptr(20)},
+			AwayTeam:       models.NRLTeam{ID: 600001, Name: "Jets", Score: ptr(10)},
+		},
+		{
+			ID:             "20191110310",
+			RoundTitle:     "Round 3",
+			MatchState:     "FullTime",
+			KickOffTime:    "2019-03-28T08:50:00Z",
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2019/round-3/jets-v-bears/",
+			HomeTeam:       models.NRLTeam{ID: 600001, Name: "Jets", Score: ptr(16)},
+			AwayTeam:       models.NRLTeam{ID: 600002, Name: "Bears", Score: ptr(16)},
+		},
+		{
+			ID:             "20981110110",
+			RoundTitle:     "Round 1",
+			MatchState:     "Upcoming",
+			KickOffTime:    "2098-03-13T08:50:00Z",
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2098/round-1/bears-v-jets/",
+			HomeTeam:       models.NRLTeam{ID: 600002, Name: "Bears"},
+			AwayTeam:       models.NRLTeam{ID: 600001, Name: "Jets"},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	headToHead, err := apiDataService.GetHeadToHead(600001, 600002)
+	if err != nil {
+		t.Fatalf("Failed to get head to head: %v", err)
+	}
+
+	assert.Equal(t, "Jets", headToHead.Team)
+	assert.Equal(t, "Bears", headToHead.Opponent)
+	assert.Equal(t, models.APIHeadToHeadRecord{Played: 3, Wins: 1, OpponentWins: 1, Draws: 1, AverageMargin: 0.7}, headToHead.Overall)
+
+	// The next meeting is at Henson Park, where the Jets have won and drawn
+	if assert.NotNil(t, headToHead.NextFixture) && assert.NotNil(t, headToHead.AtVenue) {
+		assert.Equal(t, int64(20981110110), headToHead.NextFixture.ID)
+		assert.Equal(t, models.APIHeadToHeadRecord{Played: 2, Wins: 1, Draws: 1, AverageMargin: 6}, *headToHead.AtVenue)
+	}
+
+	if assert.Equal(t, 3, len(headToHead.LastMeetings)) {
+		assert.Equal(t, "D", headToHead.LastMeetings[0].Result)
+		assert.Equal(t, "L", headToHead.LastMeetings[1].Result)
+		assert.False(t, headToHead.LastMeetings[1].Home)
+		assert.Equal(t, int32(10), headToHead.LastMeetings[1].Score)
+		assert.Equal(t, int32(20), headToHead.LastMeetings[1].OpponentScore)
+	}
+
+	// Unknown teams have no head to head
+	headToHead, err = apiDataService.GetHeadToHead(600001, 1)
+	if err != nil {
+		t.Fatalf("Failed to get head to head: %v", err)
+	}
+	assert.Nil(t, headToHead)
+}
+
+func TestHeadToHeadWithoutNextFixture(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	// Teams that have met but have no meeting scheduled
+	fixture := models.NRLFixture{
+		ID:             "20111110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "FullTime",
+		KickOffTime:    "2011-03-11T08:45:00Z",
+		Venue:          "Henson Park",
+		VenueCity:      "Sydney",
+		MatchCentreURL: "/draw/nrl-premiership/2011/round-1/jets-v-bears/",
+		HomeTeam:       models.NRLTeam{ID: 600071, Name: "Jets", Score: ptr(16)},
+		AwayTeam:       models.NRLTeam{ID: 600072, Name: "Bears", Score: ptr(12)},
+	}
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+	}
+
+	headToHead, err := apiDataService.GetHeadToHead(600071, 600072)
+	if err != nil {
+		t.Fatalf("Failed to get head to head: %v", err)
+	}
+
+	assert.Equal(t, models.APIHeadToHeadRecord{Played: 1, Wins: 1, AverageMargin: 4}, headToHead.Overall)
+	assert.Nil(t, headToHead.NextFixture)
+	assert.Nil(t, headToHead.AtVenue)
+	assert.Equal(t, 1, len(headToHead.LastMeetings))
+}
+
+func TestOriginSeriesFromResults(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	// The Maroons win the 2015 series and have retained the shield by Game 2 of 2016
+	game := func(season, number, kickOff, state string, home, away models.NRLTeam) models.NRLFixture {
+		return models.NRLFixture{
+			ID:             season + "1160" + number + "10",
+			RoundTitle:     "Game " + number,
+			MatchState:     state,
+			KickOffTime:    kickOff,
+			Venue:          "Suncorp Stadium",
+			VenueCity:      "Brisbane",
+			MatchCentreURL: "/draw/state-of-origin/" + season + "/game-" + number + "/",
+			HomeTeam:       home,
+			AwayTeam:       away,
+		}
+	}
+	fixtures := []models.NRLFixture{
+		game("2015", "1", "2015-05-27T10:00:00Z", "FullTime",
+			models.NRLTeam{ID: 600031, Name: "Blues", Score: ptr(10)}, models.NRLTeam{ID: 600032, Name: "Maroons", Score: ptr(11)}),
+		game("2015", "2", "2015-06-17T10:00:00Z", "FullTime",
+			models.NRLTeam{ID: 600032, Name: "Maroons", Score: ptr(18)}, models.NRLTeam{ID: 600031, Name: "Blues", Score: ptr(26)}),
+		game("2015", "3", "2015-07-08T10:00:00Z", "FullTime",
+			models.NRLTeam{ID: 600032, Name: "Maroons", Score: ptr(52)}, models.NRLTeam{ID: 600031, Name: "Blues", Score: ptr(6)}),
+		game("2016", "1", "2016-06-01T10:00:00Z", "FullTime",
+			models.NRLTeam{ID: 600031, Name: "Blues", Score: ptr(4)}, models.NRLTeam{ID: 600032, Name: "Maroons", Score: ptr(6)}),
+		game("2016", "2", "2016-06-22T10:00:00Z", "FullTime",
+			models.NRLTeam{ID: 600032, Name: "Maroons", Score: ptr(26)}, models.NRLTeam{ID: 600031, Name: "Blues", Score: ptr(16)}),
+		game("2016", "3", "2016-07-13T10:00:00Z", "Upcoming",
+			models.NRLTeam{ID: 600031, Name: "Blues"}, models.NRLTeam{ID: 600032, Name: "Maroons"}),
+	}
+
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	season := int32(2015)
+	series, err := apiDataService.GetCompetitionSeries(116, &season)
+	if err != nil {
+		t.Fatalf("Failed to get series: %v", err)
+	}
+
+	if assert.NotNil(t, series) {
+		assert.Equal(t, "2-1", series.Score)
+		assert.Equal(t, int32(3), series.GamesPlayed)
+		assert.True(t, series.Decided)
+		assert.Equal(t, int64(600032), *series.WinnerTeamID)
+		assert.Equal(t, int64(600032), *series.ShieldHolderTeamID)
+		assert.False(t, series.ShieldRetained)
+		assert.Equal(t, "Maroons", series.Teams[0].Nickname)
+		assert.Equal(t, int64(600031), *series.Games[1].WinnerTeamID)
+	}
+
+	// The 2016 series is decided with a game to play
+	season = 2016
+	series, err = apiDataService.GetCompetitionSeries(116, &season)
+	if err != nil {
+		t.Fatalf("Failed to get series: %v", err)
+	}
+
+	if assert.NotNil(t, series) {
+		assert.Equal(t, "2-0", series.Score)
+		assert.Equal(t, int32(2), series.GamesPlayed)
+		assert.Equal(t, int32(1), series.GamesRemaining)
+		assert.True(t, series.Decided)
+		assert.True(t, series.ShieldRetained)
+		assert.Nil(t, series.Games[2].WinnerTeamID)
+	}
+
+	// Seasons without games have no series
+	season = 2014
+	series, err = apiDataService.GetCompetitionSeries(116, &season)
+	if err != nil {
+		t.Fatalf("Failed to get series: %v", err)
+	}
+	assert.Nil(t, series)
+
+	// Competitions played in rounds are not series
+	series, err = apiDataService.GetCompetitionSeries(111, nil)
+	if err != nil {
+		t.Fatalf("Failed to get series: %v", err)
+	}
+	assert.Nil(t, series)
+}
+
+func TestUpdateRatings(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	ratingService := services.NewRatingService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	homeOdds, awayOdds := "1.50", "2.75"
+	fixtures := []models.NRLFixture{
+		{
+			ID:             "20181110110",
+			RoundTitle:     "Round 1",
+			MatchState:     "FullTime",
+			KickOffTime:    "2018-03-08T08:50:00Z",
+			Venue:          "Henson Park",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2018/round-1/hawks-v-eagles/",
+			HomeTeam:       models.NRLTeam{ID: 600011, Name: "Hawks", Score: ptr(30), Odds: &homeOdds},
+			AwayTeam:       models.NRLTeam{ID: 600012, Name: "Eagles", Score: ptr(10), Odds: &awayOdds},
+		},
+		{
+			ID:             "20181110210",
+			RoundTitle:     "Round 2",
+			MatchState:     "Upcoming",
+			KickOffTime:    "2018-03-15T08:50:00Z",
+			Venue:          "North Sydney Oval",
+			VenueCity:      "Sydney",
+			MatchCentreURL: "/draw/nrl-premiership/2018/round-2/eagles-v-hawks/",
+			HomeTeam:       models.NRLTeam{ID: 600012, Name: "Eagles"},
+			AwayTeam:       models.NRLTeam{ID: 600011, Name: "Hawks"},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	if err := ratingService.UpdateRatings(111); err != nil {
+		t.Fatalf("Failed to update ratings: %v", err)
+	}
+
+	// Evenly rated teams, so the home team is favoured by the home advantage alone
+	first, err := apiDataService.GetFixtureDetails(20181110110)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+	if assert.NotNil(t, first.Prediction) {
+		assert.Equal(t, 1500.0, first.Prediction.HomeRating)
+		assert.Equal(t, 0.571, first.Prediction.HomeWinProbability)
+		assert.Equal(t, 0.429, first.Prediction.AwayWinProbability)
+		assert.Equal(t, 2.0, first.Prediction.PredictedMargin)
+	}
+
+	// The Hawks won by 20, so are favoured away in the next round
+	second, err := apiDataService.GetFixtureDetails(20181110210)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+	if assert.NotNil(t, second.Prediction) {
+		assert.Equal(t, 1474.5, second.Prediction.HomeRating)
+		assert.Equal(t, 1525.5, second.Prediction.AwayRating)
+		assert.Less(t, second.Prediction.HomeWinProbability, 0.5)
+	}
+
+	// Later seasons regress the ratings, but they stay zero-sum between the teams
+	ratings, err := apiDataService.GetCompetitionRatings(111)
+	if err != nil {
+		t.Fatalf("Failed to get ratings: %v", err)
+	}
+
+	teamRatings := make(map[int64]models.APITeamRating)
+	for _, r := range ratings {
+		teamRatings[r.TeamID] = r
+	}
+	assert.Greater(t, teamRatings[600011].Rating, teamRatings[600012].Rating)
+	assert.InDelta(t, 3000, teamRatings[600011].Rating+teamRatings[600012].Rating, 0.2)
+	assert.Equal(t, int32(1), teamRatings[600011].Matches)
+
+	// The prediction is compared against the odds of the completed match
+	season := int32(2018)
+	calibration, err := apiDataService.GetCompetitionCalibration(111, &season)
+	if err != nil {
+		t.Fatalf("Failed to get calibration: %v", err)
+	}
+
+	assert.Equal(t, models.APICalibrationScore{Matches: 1, BrierScore: 0.184, Accuracy: 1}, calibration.Model)
+	assert.Equal(t, calibration.Model, calibration.ModelWithOdds)
+	assert.Equal(t, models.APICalibrationScore{Matches: 1, BrierScore: 0.125, Accuracy: 1}, calibration.Odds)
+	if assert.Equal(t, 1, len(calibration.Buckets)) {
+		assert.Equal(t, 0.5, calibration.Buckets[0].From)
+		assert.Equal(t, 1.0, calibration.Buckets[0].Observed)
+	}
+}
+
+func TestUpdateRatingsAtNeutralVenue(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	ratingService := services.NewRatingService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	// The Jets play their home fixtures at Henson Park, then move to Suncorp Stadium
+	for round := 1; round <= 8; round++ {
+		venue, city := "Henson Park", "Sydney"
+		if round >= 6 {
+			venue, city = "Suncorp Stadium", "Brisbane"
+		}
+
+		fixture := models.NRLFixture{
+			ID:             fmt.Sprintf("2010111%02d10", round),
+			RoundTitle:     fmt.Sprintf("Round %d", round),
+			MatchState:     "Upcoming",
+			KickOffTime:    time.Date(2010, 3, 1+7*round, 9, 0, 0, 0, time.UTC).Format(time.RFC3339),
+			Venue:          venue,
+			VenueCity:      city,
+			MatchCentreURL: fmt.Sprintf("/draw/nrl-premiership/2010/round-%d/jets-v-bears/", round),
+			HomeTeam:       models.NRLTeam{ID: 600081, Name: "Jets"},
+			AwayTeam:       models.NRLTeam{ID: 600082, Name: "Bears"},
+		}
+		if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+			t.Fatalf("Failed to store fixture %s: %v", fixture.ID, err)
+		}
+	}
+
+	if err := ratingService.UpdateRatings(111); err != nil {
+		t.Fatalf("Failed to update ratings: %v", err)
+	}
+
+	// Evenly rated teams are only separated by the home advantage at a home ground
+	home, err := apiDataService.GetFixtureDetails(20101110110)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+	if assert.NotNil(t, home.Prediction) {
+		assert.Equal(t, 0.571, home.Prediction.HomeWinProbability)
+		assert.Equal(t, 2.0, home.Prediction.PredictedMargin)
+	}
+
+	// The first match at the new venue is treated as neutral, as only the fixtures
+	// before it are counted
+	neutral, err := apiDataService.GetFixtureDetails(20101110610)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+	if assert.NotNil(t, neutral.Prediction) {
+		assert.Equal(t, 0.5, neutral.Prediction.HomeWinProbability)
+		assert.Equal(t, 0.0, neutral.Prediction.PredictedMargin)
+	}
+
+	// Once enough home fixtures have been played there it is a home ground
+	moved, err := apiDataService.GetFixtureDetails(20101110810)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+	if assert.NotNil(t, moved.Prediction) {
+		assert.Equal(t, 0.571, moved.Prediction.HomeWinProbability)
+	}
+}
+
+func TestStoreOddsDrift(t *testing.T) {
+	ctx := context.Background()
+	dataService := services.NewNRLDataService(testQueries, ctx)
+	apiDataService := services.NewAPIDataService(testQueries, ctx)
+
+	homeOdds, awayOdds := "1.50", "2.75"
+	fixture := models.NRLFixture{
+		ID:             "20171110110",
+		RoundTitle:     "Round 1",
+		MatchState:     "Upcoming",
+		KickOffTime:    "2017-03-02T08:50:00Z",
+		Venue:          "Henson Park",
+		VenueCity:      "Sydney",
+		MatchCentreURL: "/draw/nrl-premiership/2017/round-1/kites-v-gulls/",
+		HomeTeam:       models.NRLTeam{ID: 600021, Name: "Kites", Odds: &homeOdds},
+		AwayTeam:       models.NRLTeam{ID: 600022, Name: "Gulls", Odds: &awayOdds},
+	}
+
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	opening, err := testQueries.GetLatestOddsByFixtureID(ctx, 20171110110)
+	if err != nil {
+		t.Fatalf("Failed to get latest odds: %v", err)
+	}
+
+	// Unchanged odds are not recorded again
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	latest, err := testQueries.GetLatestOddsByFixtureID(ctx, 20171110110)
+	if err != nil {
+		t.Fatalf("Failed to get latest odds: %v", err)
+	}
+	assert.Equal(t, opening.ID, latest.ID)
+
+	// The market moves towards the Kites
+	homeOdds, awayOdds = "1.40", "3.00"
+	if err := dataService.StoreFixtureAndDetails(fixture); err != nil {
+		t.Fatalf("Failed to store fixture: %v", err)
+	}
+
+	apiFixture, err := apiDataService.GetFixtureDetails(20171110110)
+	if err != nil {
+		t.Fatalf("Failed to get fixture details: %v", err)
+	}
+
+	market := apiFixture.Market
+	if assert.NotNil(t, market) {
+		assert.Equal(t, 0.682, market.HomeProbability)
+		assert.Equal(t, 0.318, market.AwayProbability)
+		assert.Equal(t, int64(600021), *market.FavouriteTeamID)
+		assert.Equal(t, int64(600022), *market.UnderdogTeamID)
+		assert.False(t, market.CloseGame)
+		if assert.NotNil(t, market.HomeDrift) {
+			assert.Equal(t, 0.647, *market.OpeningHomeProbability)
+			assert.Equal(t, 0.035, *market.HomeDrift)
+		}
+	}
+}
+
+func ptr(value int) *int {
+	return &value
 }
 
 // Helper function to convert fixture ID to int64